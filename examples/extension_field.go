@@ -107,9 +107,12 @@ func demonstrateExtensionFieldConversion() {
 
 	fmt.Printf("   Extension field element: %v\n", a)
 
-	// Get constant term (unlift operation)
-	unlifted := a.Unlift()
-	fmt.Printf("   Unlifted (constant term): %v\n", unlifted)
+	// Unlifting succeeds only for lifted base field elements
+	if unlifted := a.Unlift(); unlifted != nil {
+		fmt.Printf("   Unlifted: %v\n", *unlifted)
+	} else {
+		fmt.Printf("   Not in the base field; constant term is %v\n", a.Coefficients[0])
+	}
 
 	// Create constant extension field element from base field
 	fromBase := xfield.NewConst(field.New(999))
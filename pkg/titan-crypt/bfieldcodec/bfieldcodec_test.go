@@ -369,6 +369,19 @@ func TestEncodeDecodeXFieldElement(t *testing.T) {
 	}
 }
 
+// TestXFieldElementBytesMatchCodec checks that XFieldElement.Bytes writes the
+// BFieldCodec sequence as little-endian canonical words.
+func TestXFieldElementBytesMatchCodec(t *testing.T) {
+	x := xfield.New([3]field.Element{field.New(7), field.Max, field.New(1 << 40)})
+	bytes := x.Bytes()
+	for i, e := range EncodeXFieldElement(x) {
+		word := e.Bytes()
+		if string(bytes[i*8:(i+1)*8]) != string(word[:]) {
+			t.Errorf("word %d of Bytes() = %x, want %x", i, bytes[i*8:(i+1)*8], word)
+		}
+	}
+}
+
 func TestDecodeXFieldElementErrors(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -0,0 +1,211 @@
+package extfield
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/polynomial"
+)
+
+// Element is an element c₀ + c₁·x + … + c_{d-1}·x^(d-1) of an extension Field.
+// Elements are immutable values; every operation returns a new element. The
+// zero value is not usable: obtain elements from their Field.
+//
+// Operations on two elements panic if the elements belong to different fields.
+type Element struct {
+	field  *Field
+	coeffs []field.Element
+}
+
+// Zero returns the additive identity of the field.
+func (f *Field) Zero() Element {
+	return Element{field: f, coeffs: make([]field.Element, f.degree)}
+}
+
+// One returns the multiplicative identity of the field.
+func (f *Field) One() Element {
+	return f.NewConst(field.One)
+}
+
+// X returns the indeterminate x, the generator of the extension over F_p.
+func (f *Field) X() Element {
+	return Element{field: f, coeffs: f.x()}
+}
+
+// New returns the element with the given coefficients [c₀, c₁, …]. Missing
+// high coefficients are zero. The coefficients are copied.
+//
+// Panics if more than Degree() coefficients are given.
+func (f *Field) New(coefficients []field.Element) Element {
+	if len(coefficients) > f.degree {
+		panic(fmt.Sprintf("extfield: %d coefficients for an extension of degree %d", len(coefficients), f.degree))
+	}
+	coeffs := make([]field.Element, f.degree)
+	copy(coeffs, coefficients)
+	return Element{field: f, coeffs: coeffs}
+}
+
+// NewConst returns the base field element c lifted into the extension.
+func (f *Field) NewConst(c field.Element) Element {
+	return Element{field: f, coeffs: f.constant(c)}
+}
+
+// Field returns the field the element belongs to.
+func (a Element) Field() *Field {
+	return a.field
+}
+
+// Coefficients returns a copy of the coefficients [c₀, c₁, …, c_{d-1}].
+func (a Element) Coefficients() []field.Element {
+	return append([]field.Element(nil), a.coeffs...)
+}
+
+// sameField panics unless a and b belong to the same field.
+func (a Element) sameField(b Element) {
+	if a.field != b.field {
+		panic("extfield: elements belong to different fields")
+	}
+}
+
+// IsZero returns true if a is the additive identity.
+func (a Element) IsZero() bool {
+	for _, c := range a.coeffs {
+		if !c.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsOne returns true if a is the multiplicative identity.
+func (a Element) IsOne() bool {
+	return a.coeffs[0].IsOne() && a.IsInBaseField()
+}
+
+// IsInBaseField returns true if a lies in F_p, i.e. c₁ = … = c_{d-1} = 0.
+func (a Element) IsInBaseField() bool {
+	for _, c := range a.coeffs[1:] {
+		if !c.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// TryUnlift returns the constant term of a and whether a lies in F_p. If it
+// does not, the returned value is zero.
+func (a Element) TryUnlift() (field.Element, bool) {
+	if !a.IsInBaseField() {
+		return field.Zero, false
+	}
+	return a.coeffs[0], true
+}
+
+// Equal returns true if a and b are the same element of the same field.
+func (a Element) Equal(b Element) bool {
+	return a.field == b.field && equalCoefficients(a.coeffs, b.coeffs)
+}
+
+// Add returns a + b.
+func (a Element) Add(b Element) Element {
+	a.sameField(b)
+	coeffs := make([]field.Element, len(a.coeffs))
+	for i := range coeffs {
+		coeffs[i] = a.coeffs[i].Add(b.coeffs[i])
+	}
+	return Element{field: a.field, coeffs: coeffs}
+}
+
+// AddConst returns a + c for a base field element c.
+func (a Element) AddConst(c field.Element) Element {
+	coeffs := a.Coefficients()
+	coeffs[0] = coeffs[0].Add(c)
+	return Element{field: a.field, coeffs: coeffs}
+}
+
+// Sub returns a - b.
+func (a Element) Sub(b Element) Element {
+	a.sameField(b)
+	coeffs := make([]field.Element, len(a.coeffs))
+	for i := range coeffs {
+		coeffs[i] = a.coeffs[i].Sub(b.coeffs[i])
+	}
+	return Element{field: a.field, coeffs: coeffs}
+}
+
+// Neg returns -a.
+func (a Element) Neg() Element {
+	coeffs := make([]field.Element, len(a.coeffs))
+	for i, c := range a.coeffs {
+		coeffs[i] = c.Neg()
+	}
+	return Element{field: a.field, coeffs: coeffs}
+}
+
+// Mul returns a · b.
+func (a Element) Mul(b Element) Element {
+	a.sameField(b)
+	return Element{field: a.field, coeffs: a.field.mul(a.coeffs, b.coeffs)}
+}
+
+// MulConst returns a · c for a base field element c, multiplying each coefficient.
+func (a Element) MulConst(c field.Element) Element {
+	coeffs := make([]field.Element, len(a.coeffs))
+	for i, ai := range a.coeffs {
+		coeffs[i] = ai.Mul(c)
+	}
+	return Element{field: a.field, coeffs: coeffs}
+}
+
+// Square returns a².
+func (a Element) Square() Element {
+	return a.Mul(a)
+}
+
+// Pow returns a^exponent by square-and-multiply. a^0 is One, also for zero a.
+func (a Element) Pow(exponent uint64) Element {
+	return Element{field: a.field, coeffs: a.field.pow(a.coeffs, exponent)}
+}
+
+// Inverse returns the multiplicative inverse of a, computed with the extended
+// Euclidean algorithm against the field modulus.
+//
+// Panics if a is zero.
+func (a Element) Inverse() Element {
+	if a.IsZero() {
+		panic("cannot invert the zero element in the extension field")
+	}
+	_, inverse, _ := polynomial.XGCD(polynomial.New(a.coeffs), a.field.modulus)
+	return a.field.New(inverse.Coefficients())
+}
+
+// Div returns a / b.
+//
+// Panics if b is zero.
+func (a Element) Div(b Element) Element {
+	return a.Mul(b.Inverse())
+}
+
+// Frobenius applies the Frobenius automorphism φ(a) = a^p, using the
+// precomputed images of the basis instead of a full Pow.
+func (a Element) Frobenius() Element {
+	return Element{field: a.field, coeffs: a.field.applyFrobenius(a.coeffs)}
+}
+
+// String returns the element in polynomial form, highest power first, e.g.
+// (3·x^2 + 2·x + 1) in a cubic extension.
+func (a Element) String() string {
+	terms := make([]string, len(a.coeffs))
+	for i, c := range a.coeffs {
+		switch i {
+		case 0:
+			terms[len(terms)-1] = fmt.Sprintf("%d", c.Value())
+		case 1:
+			terms[len(terms)-2] = fmt.Sprintf("%d·x", c.Value())
+		default:
+			terms[len(terms)-1-i] = fmt.Sprintf("%d·x^%d", c.Value(), i)
+		}
+	}
+	return "(" + strings.Join(terms, " + ") + ")"
+}
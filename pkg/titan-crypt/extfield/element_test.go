@@ -0,0 +1,143 @@
+package extfield
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/xfield"
+)
+
+func randomElement(f *Field, rng *rand.Rand) Element {
+	coeffs := make([]field.Element, f.Degree())
+	for i := range coeffs {
+		coeffs[i] = field.New(rng.Uint64())
+	}
+	return f.New(coeffs)
+}
+
+func TestMatchesXField(t *testing.T) {
+	rng := rand.New(rand.NewSource(551))
+	f, err := New(3, xfield.ShahPolynomial())
+	if err != nil {
+		t.Fatal(err)
+	}
+	lift := func(x xfield.XFieldElement) Element { return f.New(x.Coefficients[:]) }
+
+	for i := 0; i < 100; i++ {
+		x := xfield.New([3]field.Element{field.New(rng.Uint64()), field.New(rng.Uint64()), field.New(rng.Uint64())})
+		y := xfield.New([3]field.Element{field.New(rng.Uint64()), field.New(rng.Uint64()), field.New(rng.Uint64())})
+		a, b := lift(x), lift(y)
+		exp := rng.Uint64()
+
+		if !a.Add(b).Equal(lift(x.Add(y))) || !a.Sub(b).Equal(lift(x.Sub(y))) ||
+			!a.Mul(b).Equal(lift(x.Mul(y))) || !a.Neg().Equal(lift(x.Neg())) ||
+			!a.Inverse().Equal(lift(x.Inverse())) || !a.Pow(exp).Equal(lift(x.Pow(exp))) ||
+			!a.Frobenius().Equal(lift(x.Frobenius())) {
+			t.Fatalf("arithmetic on %v and %v differs from xfield", x, y)
+		}
+	}
+}
+
+func TestFieldAxioms(t *testing.T) {
+	rng := rand.New(rand.NewSource(551))
+	linear, err := New(1, poly(5, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range []*Field{linear, Quadratic(), Sextic()} {
+		for i := 0; i < 20; i++ {
+			a, b, c := randomElement(f, rng), randomElement(f, rng), randomElement(f, rng)
+
+			if !a.Mul(b.Add(c)).Equal(a.Mul(b).Add(a.Mul(c))) || !a.Mul(b).Mul(c).Equal(a.Mul(b.Mul(c))) {
+				t.Fatalf("degree %d: distributivity or associativity fails", f.Degree())
+			}
+			if !a.Mul(a.Inverse()).IsOne() || !a.Div(b).Mul(b).Equal(a) {
+				t.Fatalf("degree %d: inverse of %v fails", f.Degree(), a)
+			}
+			if !a.Frobenius().Equal(a.Pow(field.P)) || !a.Square().Equal(a.Mul(a)) {
+				t.Fatalf("degree %d: Frobenius or Square of %v fails", f.Degree(), a)
+			}
+
+			// φ has order d
+			y := a
+			for k := 0; k < f.Degree(); k++ {
+				y = y.Frobenius()
+			}
+			if !y.Equal(a) {
+				t.Fatalf("degree %d: φ^d(%v) = %v", f.Degree(), a, y)
+			}
+
+			s := field.New(rng.Uint64())
+			if !a.MulConst(s).Equal(a.Mul(f.NewConst(s))) || !a.AddConst(s).Equal(a.Add(f.NewConst(s))) {
+				t.Fatalf("degree %d: mixed operations differ from lifted ones", f.Degree())
+			}
+		}
+	}
+}
+
+func TestQuadraticDefiningRelation(t *testing.T) {
+	f := Quadratic()
+	if got, ok := f.X().Square().TryUnlift(); !ok || got.Value() != 7 {
+		t.Errorf("x² = %v, want 7", f.X().Square())
+	}
+	if got, ok := Sextic().X().Pow(6).TryUnlift(); !ok || got.Value() != 7 {
+		t.Errorf("x⁶ = %v, want 7", Sextic().X().Pow(6))
+	}
+	if _, ok := f.X().TryUnlift(); ok {
+		t.Error("x should not unlift")
+	}
+}
+
+func TestElementConstruction(t *testing.T) {
+	f := Sextic()
+	a := f.New([]field.Element{field.New(1), field.New(2)})
+	coeffs := a.Coefficients()
+	if len(coeffs) != 6 || coeffs[1].Value() != 2 || !coeffs[5].IsZero() {
+		t.Errorf("New padded to %v", coeffs)
+	}
+	coeffs[0] = field.New(9)
+	if a.Coefficients()[0].Value() != 1 {
+		t.Error("Coefficients should return a copy")
+	}
+
+	if !f.Zero().IsZero() || !f.One().IsOne() || f.X().IsInBaseField() || f.One().Field() != f {
+		t.Error("unexpected constants")
+	}
+	if got := Quadratic().New([]field.Element{field.New(1), field.New(2)}).String(); got != "(2·x + 1)" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := Sextic().X().Pow(3).String(); got != "(0·x^5 + 0·x^4 + 1·x^3 + 0·x^2 + 0·x + 0)" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestElementPanics(t *testing.T) {
+	expectPanic := func(name string, fn func()) {
+		t.Helper()
+		defer func() {
+			if recover() == nil {
+				t.Errorf("%s should panic", name)
+			}
+		}()
+		fn()
+	}
+
+	expectPanic("mixed fields", func() { Quadratic().One().Add(Sextic().One()) })
+	expectPanic("too many coefficients", func() { Quadratic().New(make([]field.Element, 3)) })
+	expectPanic("inverse of zero", func() { Quadratic().Zero().Inverse() })
+
+	if Quadratic().One().Equal(Sextic().One()) {
+		t.Error("elements of different fields should not be equal")
+	}
+}
+
+func BenchmarkSexticMul(b *testing.B) {
+	rng := rand.New(rand.NewSource(551))
+	x, y := randomElement(Sextic(), rng), randomElement(Sextic(), rng)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x = x.Mul(y)
+	}
+}
@@ -0,0 +1,222 @@
+// Package extfield provides arithmetic in extensions F_p[x]/(f) of the
+// Goldilocks field of any degree, for protocols that need an extension other
+// than the fixed F_p³ of package xfield, such as a quadratic one.
+//
+// A Field is built once from a monic irreducible polynomial f of degree d, and
+// its elements are represented as c₀ + c₁·x + … + c_{d-1}·x^(d-1). Element
+// mirrors the arithmetic of xfield.XFieldElement, but its degree is known only
+// at run time, so it stores its coefficients in a slice and every operation
+// allocates. Use xfield for F_p³, where the fixed-size representation is much
+// faster.
+package extfield
+
+import (
+	"fmt"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/polynomial"
+)
+
+// Field is the extension F_p[x]/(f) for a monic irreducible polynomial f.
+// It is immutable and safe for concurrent use.
+type Field struct {
+	degree  int
+	modulus *polynomial.Polynomial
+
+	// reduction holds the coefficients of x^d reduced modulo f, i.e. -f₀ … -f_{d-1}
+	reduction []field.Element
+
+	// frobenius[i] holds the coefficients of φ(x^i) = x^(i·p) modulo f
+	frobenius [][]field.Element
+}
+
+var (
+	quadratic = mustNew(2, polynomial.New([]field.Element{field.New(7).Neg(), field.Zero, field.One}))
+	sextic    = mustNew(6, polynomial.New([]field.Element{field.New(7).Neg(), field.Zero, field.Zero, field.Zero, field.Zero, field.Zero, field.One}))
+)
+
+// Quadratic returns the extension F_p[x]/(x² - 7). Since 7 generates the
+// multiplicative group of F_p, it is not a square, so x² - 7 is irreducible.
+func Quadratic() *Field {
+	return quadratic
+}
+
+// Sextic returns the extension F_p[x]/(x⁶ - 7). Since 7 generates the
+// multiplicative group of F_p, it is neither a square nor a cube, and 2 and 3
+// both divide p - 1, so x⁶ - 7 is irreducible.
+func Sextic() *Field {
+	return sextic
+}
+
+func mustNew(degree int, irreducible *polynomial.Polynomial) *Field {
+	f, err := New(degree, irreducible)
+	if err != nil {
+		panic(fmt.Sprintf("extfield: %v", err))
+	}
+	return f
+}
+
+// New returns the extension field F_p[x]/(f) of the given degree, where f is
+// irreducible. A non-monic f is scaled to be monic, which defines the same
+// field. Irreducibility is checked with Rabin's test, which costs
+// O(d·log p) multiplications in the field plus a gcd per prime factor of d.
+// Returns an error if degree is less than 1, if irreducible does not have that
+// degree, or if it is reducible over F_p.
+func New(degree int, irreducible *polynomial.Polynomial) (*Field, error) {
+	if degree < 1 {
+		return nil, fmt.Errorf("extension degree must be at least 1, got %d", degree)
+	}
+	if irreducible.Degree() != degree {
+		return nil, fmt.Errorf("polynomial has degree %d, want %d", irreducible.Degree(), degree)
+	}
+
+	modulus := irreducible.Monic()
+	coeffs := modulus.Coefficients()
+	f := &Field{
+		degree:    degree,
+		modulus:   modulus,
+		reduction: make([]field.Element, degree),
+	}
+	for i := range f.reduction {
+		f.reduction[i] = coeffs[i].Neg()
+	}
+
+	// The Frobenius map is F_p-linear on F_p[x]/(f) whether or not f is
+	// irreducible, so the basis images can be computed before Rabin's test.
+	xp := f.pow(f.x(), field.P)
+	f.frobenius = make([][]field.Element, degree)
+	f.frobenius[0] = f.constant(field.One)
+	for i := 1; i < degree; i++ {
+		f.frobenius[i] = f.mul(f.frobenius[i-1], xp)
+	}
+
+	if !f.rabinIrreducible() {
+		return nil, fmt.Errorf("polynomial %v is reducible over F_p", modulus)
+	}
+	return f, nil
+}
+
+// rabinIrreducible reports whether the modulus is irreducible: a degree-d
+// polynomial f is irreducible exactly when x^(p^d) ≡ x mod f and
+// gcd(x^(p^(d/q)) - x, f) = 1 for every prime q dividing d.
+func (f *Field) rabinIrreducible() bool {
+	x := f.x()
+	conjugates := make([][]field.Element, f.degree+1) // conjugates[k] = x^(p^k)
+	conjugates[0] = x
+	for k := 1; k <= f.degree; k++ {
+		conjugates[k] = f.applyFrobenius(conjugates[k-1])
+	}
+	if !equalCoefficients(conjugates[f.degree], x) {
+		return false
+	}
+
+	for _, q := range primeFactors(f.degree) {
+		diff := make([]field.Element, f.degree)
+		for i := range diff {
+			diff[i] = conjugates[f.degree/q][i].Sub(x[i])
+		}
+		gcd, _, _ := polynomial.XGCD(polynomial.New(diff), f.modulus)
+		if gcd.Degree() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// primeFactors returns the distinct prime factors of n > 0 in increasing order.
+func primeFactors(n int) []int {
+	var factors []int
+	for q := 2; q*q <= n; q++ {
+		if n%q == 0 {
+			factors = append(factors, q)
+			for n%q == 0 {
+				n /= q
+			}
+		}
+	}
+	if n > 1 {
+		factors = append(factors, n)
+	}
+	return factors
+}
+
+// Degree returns the degree d of the extension over F_p.
+func (f *Field) Degree() int {
+	return f.degree
+}
+
+// Modulus returns the monic irreducible polynomial defining the extension.
+func (f *Field) Modulus() *polynomial.Polynomial {
+	return f.modulus.Clone()
+}
+
+// x returns the coefficients of the indeterminate reduced modulo f, which
+// differs from the unit vector only for degree 1.
+func (f *Field) x() []field.Element {
+	if f.degree == 1 {
+		return []field.Element{f.reduction[0]}
+	}
+	coeffs := make([]field.Element, f.degree)
+	coeffs[1] = field.One
+	return coeffs
+}
+
+// constant returns the coefficients of the base field element c.
+func (f *Field) constant(c field.Element) []field.Element {
+	coeffs := make([]field.Element, f.degree)
+	coeffs[0] = c
+	return coeffs
+}
+
+// mul returns the coefficients of a·b modulo f by schoolbook multiplication
+// followed by folding the high terms from the top with x^d = Σ reduction[j]·x^j.
+func (f *Field) mul(a, b []field.Element) []field.Element {
+	d := f.degree
+	product := make([]field.Element, 2*d-1)
+	for i, ai := range a {
+		for j, bj := range b {
+			product[i+j] = product[i+j].Add(ai.Mul(bj))
+		}
+	}
+	for i := 2*d - 2; i >= d; i-- {
+		c := product[i]
+		for j, r := range f.reduction {
+			product[i-d+j] = product[i-d+j].Add(c.Mul(r))
+		}
+	}
+	return product[:d:d]
+}
+
+// pow returns the coefficients of a^exponent modulo f by square-and-multiply.
+func (f *Field) pow(a []field.Element, exponent uint64) []field.Element {
+	result := f.constant(field.One)
+	for base := a; exponent > 0; exponent >>= 1 {
+		if exponent&1 == 1 {
+			result = f.mul(result, base)
+		}
+		if exponent > 1 {
+			base = f.mul(base, base)
+		}
+	}
+	return result
+}
+
+// applyFrobenius returns the coefficients of φ(a) = a^p as Σ a_i·φ(x^i).
+func (f *Field) applyFrobenius(a []field.Element) []field.Element {
+	result := make([]field.Element, f.degree)
+	for i, ai := range a {
+		for j, img := range f.frobenius[i] {
+			result[j] = result[j].Add(ai.Mul(img))
+		}
+	}
+	return result
+}
+
+func equalCoefficients(a, b []field.Element) bool {
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+package extfield
+
+import (
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/polynomial"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/xfield"
+)
+
+func poly(coeffs ...uint64) *polynomial.Polynomial {
+	elements := make([]field.Element, len(coeffs))
+	for i, c := range coeffs {
+		elements[i] = field.New(c)
+	}
+	return polynomial.New(elements)
+}
+
+func TestNewAcceptsIrreducible(t *testing.T) {
+	tests := []struct {
+		name   string
+		degree int
+		f      *polynomial.Polynomial
+	}{
+		{"linear", 1, poly(5, 1)},
+		{"quadratic", 2, Quadratic().Modulus()},
+		{"cubic Shah", 3, xfield.ShahPolynomial()},
+		{"sextic", 6, Sextic().Modulus()},
+		{"non-monic quadratic", 2, poly(field.P-14, 0, 2)}, // 2·(x² - 7)
+	}
+
+	for _, tt := range tests {
+		f, err := New(tt.degree, tt.f)
+		if err != nil {
+			t.Errorf("%s: New failed: %v", tt.name, err)
+			continue
+		}
+		if f.Degree() != tt.degree || !f.Modulus().Equal(tt.f.Monic()) {
+			t.Errorf("%s: got degree %d and modulus %v", tt.name, f.Degree(), f.Modulus())
+		}
+	}
+}
+
+func TestNewRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		degree int
+		f      *polynomial.Polynomial
+	}{
+		{"degree zero", 0, poly(1)},
+		{"degree mismatch", 3, poly(field.P-7, 0, 1)},
+		{"root 2", 2, poly(field.P-4, 0, 1)},                         // x² - 4
+		{"square of quadratic", 4, poly(49, 0, field.P-14, 0, 1)},    // (x² - 7)²
+		{"product of quadratics", 4, poly(7*3, 0, field.P-10, 0, 1)}, // (x² - 7)(x² - 3)
+		{"root 1 in sextic", 6, poly(field.P-1, 0, 0, 0, 0, 0, 1)},   // x⁶ - 1
+		{"x² has root 0", 2, poly(0, 0, 1)},
+	}
+
+	for _, tt := range tests {
+		if _, err := New(tt.degree, tt.f); err == nil {
+			t.Errorf("%s: New(%d, %v) should fail", tt.name, tt.degree, tt.f)
+		}
+	}
+}
+
+func TestPrimeFactors(t *testing.T) {
+	tests := map[int][]int{1: nil, 2: {2}, 6: {2, 3}, 12: {2, 3}, 49: {7}, 30: {2, 3, 5}}
+	for n, want := range tests {
+		got := primeFactors(n)
+		if len(got) != len(want) {
+			t.Errorf("primeFactors(%d) = %v, want %v", n, got, want)
+			continue
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("primeFactors(%d) = %v, want %v", n, got, want)
+			}
+		}
+	}
+}
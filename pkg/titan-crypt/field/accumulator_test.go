@@ -0,0 +1,117 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestAccumulatorMatchesNaive(t *testing.T) {
+	rng := rand.New(rand.NewSource(521))
+	for _, n := range []int{0, 1, 2, 100, 5000} {
+		var acc Accumulator
+		want := Zero
+		for i := 0; i < n; i++ {
+			x, y := New(rng.Uint64()), New(rng.Uint64())
+			acc = acc.MulAdd(x, y)
+			want = want.Add(x.Mul(y))
+
+			if i%7 == 0 {
+				z := New(rng.Uint64())
+				acc = acc.Add(z)
+				want = want.Add(z)
+			}
+		}
+		if got := acc.Reduce(); !got.Equal(want) {
+			t.Fatalf("n=%d: Reduce() = %v, want %v", n, got, want)
+		}
+	}
+}
+
+func TestAccumulatorWorstCase(t *testing.T) {
+	// Every product is (P-1)², the largest possible, driving the top word up.
+	var acc Accumulator
+	maxRaw := NewFromRaw(P - 1)
+	want := Zero
+	const n = 1 << 16
+	for i := 0; i < n; i++ {
+		acc = acc.MulAdd(maxRaw, maxRaw)
+	}
+	want = maxRaw.Square().Mul(New(n))
+	if acc.top == 0 {
+		t.Fatal("test did not exercise the top word")
+	}
+	if got := acc.Reduce(); !got.Equal(want) {
+		t.Errorf("Reduce() = %v, want %v", got, want)
+	}
+
+	if !(Accumulator{}).Reduce().IsZero() {
+		t.Error("empty accumulator should reduce to zero")
+	}
+}
+
+func TestAccumulatorMulAddSlices(t *testing.T) {
+	rng := rand.New(rand.NewSource(521))
+	xs, ys := make([]Element, 300), make([]Element, 300)
+	for i := range xs {
+		xs[i], ys[i] = New(rng.Uint64()), New(rng.Uint64())
+	}
+
+	var perTerm, sliced Accumulator
+	perTerm = perTerm.Add(Max)
+	sliced = sliced.Add(Max)
+	for i := range xs {
+		perTerm = perTerm.MulAdd(xs[i], ys[i])
+	}
+	sliced = sliced.MulAddSlices(xs[:100], ys[:100])
+	sliced = sliced.MulAddSlices(xs[100:], ys[100:])
+	if !sliced.Reduce().Equal(perTerm.Reduce()) {
+		t.Errorf("MulAddSlices = %v, MulAdd loop = %v", sliced.Reduce(), perTerm.Reduce())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for mismatched lengths")
+		}
+	}()
+	sliced.MulAddSlices(xs[:2], ys[:3])
+}
+
+func BenchmarkAccumulatorDot1024(b *testing.B) {
+	xs, ys := benchmarkDotInputs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Accumulator{}.MulAddSlices(xs, ys).Reduce()
+	}
+}
+
+func BenchmarkAccumulatorMulAdd1024(b *testing.B) {
+	xs, ys := benchmarkDotInputs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var acc Accumulator
+		for j := range xs {
+			acc = acc.MulAdd(xs[j], ys[j])
+		}
+		acc.Reduce()
+	}
+}
+
+func BenchmarkNaiveDot1024(b *testing.B) {
+	xs, ys := benchmarkDotInputs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := Zero
+		for j := range xs {
+			sum = sum.Add(xs[j].Mul(ys[j]))
+		}
+	}
+}
+
+func benchmarkDotInputs() ([]Element, []Element) {
+	rng := rand.New(rand.NewSource(521))
+	xs, ys := make([]Element, 1024), make([]Element, 1024)
+	for i := range xs {
+		xs[i], ys[i] = New(rng.Uint64()), New(rng.Uint64())
+	}
+	return xs, ys
+}
@@ -0,0 +1,62 @@
+package field
+
+import "math/bits"
+
+// Barrett reduction of x modulo P uses μ = ⌊2^128 / P⌋ = 2^64 + barrettMu, so
+// the quotient estimate ⌊x·μ / 2^128⌋ needs only shifts, additions and
+// x·barrettMu. The estimate is at most two below the true quotient.
+const barrettMu uint64 = 1<<32 - 1
+
+// rInverse is R⁻¹ = 2^-64 mod P. Since 2^96 ≡ -1, 2^-64 ≡ 2^128 ≡ -2^32 (mod P).
+const rInverse uint64 = P - 1<<32
+
+// barrettReduce returns x mod P as a canonical value with Barrett reduction.
+// Unlike the default montyred and reduce128 it relies on no special form of P
+// beyond the value of barrettMu. Its corrections are fixed in number and
+// selected with masks, so, like the default backend, it does not branch on x
+// and Mul stays branch-free under the barrett tag, as package ct requires.
+func barrettReduce(x uint128) uint64 {
+	// Subtracting P·2^64 keeps x mod P and ensures x < P·2^64. The
+	// subtraction is selected with a mask, so the time does not depend on x.
+	x.hi = condSubP(x.hi)
+
+	// S = x·2^64 + x·barrettMu as three limbs; the quotient estimate is the
+	// top limb, which fits in 64 bits because x < P·2^64
+	loHi, _ := bits.Mul64(x.lo, barrettMu)
+	hiHi, hiLo := bits.Mul64(x.hi, barrettMu)
+
+	mid, c1 := bits.Add64(x.lo, loHi, 0)
+	_, c2 := bits.Add64(mid, hiLo, 0)
+	q := x.hi + hiHi + c1 + c2
+
+	// r = x - q·P < 3P, which may need 66 bits
+	qpHi, qpLo := bits.Mul64(q, P)
+	rLo, borrow := bits.Sub64(x.lo, qpLo, 0)
+	rHi, _ := bits.Sub64(x.hi, qpHi, borrow)
+
+	// Two masked conditional subtractions bring r < 3P below P
+	rHi, rLo = condSubP128(rHi, rLo)
+	_, rLo = condSubP128(rHi, rLo)
+	return rLo
+}
+
+// condSubP128 returns r - P if r = hi·2^64 + lo >= P and r otherwise, without branching.
+func condSubP128(hi, lo uint64) (uint64, uint64) {
+	sLo, borrow := bits.Sub64(lo, P, 0)
+	sHi, borrow := bits.Sub64(hi, 0, borrow)
+	keep := -borrow // all ones if r < P
+	return (hi & keep) | (sHi &^ keep), (lo & keep) | (sLo &^ keep)
+}
+
+// condSubP returns v - P if v >= P and v otherwise, without branching.
+func condSubP(v uint64) uint64 {
+	s, borrow := bits.Sub64(v, P, 0)
+	keep := -borrow
+	return (v & keep) | (s &^ keep)
+}
+
+// barrettMontyred returns x·R⁻¹ mod P, the same value as the default montyred
+// for x < P·2^64, by reducing x and multiplying the result by R⁻¹.
+func barrettMontyred(x uint128) uint64 {
+	return barrettReduce(mul128(barrettReduce(x), rInverse))
+}
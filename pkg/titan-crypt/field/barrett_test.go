@@ -0,0 +1,81 @@
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestBarrettConstants(t *testing.T) {
+	two128 := new(big.Int).Lsh(big.NewInt(1), 128)
+	mu := new(big.Int).Div(two128, new(big.Int).SetUint64(P))
+	want := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 64), new(big.Int).SetUint64(barrettMu))
+	if mu.Cmp(want) != 0 {
+		t.Errorf("⌊2^128/P⌋ = %s, want 2^64 + %d", mu, barrettMu)
+	}
+
+	// R·R⁻¹ ≡ 1 (mod P)
+	r := new(big.Int).Lsh(big.NewInt(1), 64)
+	prod := new(big.Int).Mul(r, new(big.Int).SetUint64(rInverse))
+	if prod.Mod(prod, new(big.Int).SetUint64(P)).Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("rInverse = %d is not 2^-64 mod P", rInverse)
+	}
+}
+
+func barrettInputs(rng *rand.Rand) []uint128 {
+	inputs := []uint128{
+		{0, 0}, {P - 1, 0}, {P, 0}, {^uint64(0), 0},
+		{^uint64(0), P - 1}, {0, P - 1}, {0, P}, {^uint64(0), ^uint64(0)},
+		mul128(P-1, P-1), mul128(P-1, R2),
+	}
+	for i := 0; i < 2000; i++ {
+		inputs = append(inputs,
+			uint128{lo: rng.Uint64(), hi: rng.Uint64()},
+			mul128(rng.Uint64()%P, rng.Uint64()%P))
+	}
+	return inputs
+}
+
+func TestBarrettReduce(t *testing.T) {
+	mod := new(big.Int).SetUint64(P)
+	for _, x := range barrettInputs(rand.New(rand.NewSource(530))) {
+		want := new(big.Int).Lsh(new(big.Int).SetUint64(x.hi), 64)
+		want.Add(want, new(big.Int).SetUint64(x.lo)).Mod(want, mod)
+		if got := barrettReduce(x); got != want.Uint64() {
+			t.Fatalf("barrettReduce(%d·2^64 + %d) = %d, want %d", x.hi, x.lo, got, want.Uint64())
+		}
+	}
+}
+
+// TestMontyredBackends checks both reduction backends against x·2^-64 mod P on
+// the inputs montyred receives, x < P·2^64, whichever one the build selected.
+func TestMontyredBackends(t *testing.T) {
+	mod := new(big.Int).SetUint64(P)
+	rInv := new(big.Int).ModInverse(new(big.Int).Lsh(big.NewInt(1), 64), mod)
+	for _, x := range barrettInputs(rand.New(rand.NewSource(530))) {
+		if x.hi >= P {
+			continue
+		}
+		want := new(big.Int).Lsh(new(big.Int).SetUint64(x.hi), 64)
+		want.Add(want, new(big.Int).SetUint64(x.lo)).Mul(want, rInv).Mod(want, mod)
+
+		if got := montyred(x); got != want.Uint64() {
+			t.Fatalf("montyred(%d·2^64 + %d) = %d, want %d", x.hi, x.lo, got, want.Uint64())
+		}
+		if got := barrettMontyred(x); got != want.Uint64() {
+			t.Fatalf("barrettMontyred(%d·2^64 + %d) = %d, want %d", x.hi, x.lo, got, want.Uint64())
+		}
+	}
+}
+
+func TestCondSubP(t *testing.T) {
+	for _, v := range []uint64{0, 1, P - 1, P, P + 1, ^uint64(0)} {
+		want := v
+		if v >= P {
+			want = v - P
+		}
+		if got := condSubP(v); got != want {
+			t.Errorf("condSubP(%d) = %d, want %d", v, got, want)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package field
+
+// BatchInverse returns the inverse of every element using Montgomery's trick:
+// one field inversion plus three multiplications per element. Zeros have no
+// inverse and are mapped to Zero, without affecting the other entries.
+func BatchInverse(values []Element) []Element {
+	result := make([]Element, len(values))
+	copy(result, values)
+	BatchInverseInPlace(result)
+	return result
+}
+
+// BatchInverseInPlace replaces every element of values by its inverse, as
+// BatchInverse does. It allocates one scratch slice for the prefix products.
+func BatchInverseInPlace(values []Element) {
+	if len(values) == 0 {
+		return
+	}
+
+	// prefix[i] is the product of the nonzero entries before index i
+	prefix := make([]Element, len(values))
+	acc := One
+	for i, v := range values {
+		prefix[i] = acc
+		if !v.IsZero() {
+			acc = acc.Mul(v)
+		}
+	}
+
+	inv := acc.Inverse()
+	for i := len(values) - 1; i >= 0; i-- {
+		v := values[i]
+		if v.IsZero() {
+			continue
+		}
+		values[i] = prefix[i].Mul(inv)
+		inv = inv.Mul(v)
+	}
+}
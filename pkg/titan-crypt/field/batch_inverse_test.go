@@ -0,0 +1,73 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBatchInverse(t *testing.T) {
+	values := []Element{New(1), New(2), Zero, New(P - 1), New(12345), Zero}
+	inverses := BatchInverse(values)
+
+	for i, v := range values {
+		if v.IsZero() {
+			if !inverses[i].IsZero() {
+				t.Errorf("inverse of zero at %d = %v, want 0", i, inverses[i])
+			}
+			continue
+		}
+		if !v.Mul(inverses[i]).IsOne() {
+			t.Errorf("values[%d] * inverses[%d] != 1", i, i)
+		}
+	}
+
+	if got := BatchInverse(nil); len(got) != 0 {
+		t.Errorf("BatchInverse(nil) = %v", got)
+	}
+}
+
+func TestBatchInverseInPlace(t *testing.T) {
+	rng := rand.New(rand.NewSource(501))
+	values := make([]Element, 1000)
+	for i := range values {
+		if i%17 != 0 {
+			values[i] = New(rng.Uint64())
+		}
+	}
+
+	want := make([]Element, len(values))
+	for i, v := range values {
+		if !v.IsZero() {
+			want[i] = v.Inverse()
+		}
+	}
+
+	got := BatchInverse(values)
+	BatchInverseInPlace(values)
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Fatalf("BatchInverse[%d] = %v, want %v", i, got[i], want[i])
+		}
+		if !values[i].Equal(want[i]) {
+			t.Fatalf("BatchInverseInPlace[%d] = %v, want %v", i, values[i], want[i])
+		}
+	}
+
+	allZero := []Element{Zero, Zero}
+	BatchInverseInPlace(allZero)
+	if !allZero[0].IsZero() || !allZero[1].IsZero() {
+		t.Errorf("all-zero input changed to %v", allZero)
+	}
+}
+
+func BenchmarkBatchInverse1024(b *testing.B) {
+	rng := rand.New(rand.NewSource(501))
+	values := make([]Element, 1024)
+	for i := range values {
+		values[i] = New(rng.Uint64())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BatchInverse(values)
+	}
+}
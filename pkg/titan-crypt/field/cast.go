@@ -0,0 +1,43 @@
+package field
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Element is a struct holding a single uint64, so []Element and []uint64 share
+// a memory layout. The casts below reinterpret one as the other without copying;
+// the results alias their input.
+//
+// The words are raw Montgomery-form values (RawValue), not canonical values, so
+// they are meaningful only to code that treats them as opaque storage, e.g. when
+// writing a trace table to a file or handing it to a foreign function, and
+// reading it back with the same casts.
+
+// ElementsAsUint64s returns the raw Montgomery words of elements, sharing its memory.
+func ElementsAsUint64s(elements []Element) []uint64 {
+	if len(elements) == 0 {
+		return []uint64{}
+	}
+	return unsafe.Slice((*uint64)(unsafe.Pointer(&elements[0])), len(elements))
+}
+
+// Uint64sAsElements reinterprets raw Montgomery words as elements, sharing their memory.
+// The words must be less than P; use Uint64sAsElementsChecked for untrusted input.
+func Uint64sAsElements(words []uint64) []Element {
+	if len(words) == 0 {
+		return []Element{}
+	}
+	return unsafe.Slice((*Element)(unsafe.Pointer(&words[0])), len(words))
+}
+
+// Uint64sAsElementsChecked is Uint64sAsElements for untrusted input.
+// Returns an error naming the first word that is not less than P.
+func Uint64sAsElementsChecked(words []uint64) ([]Element, error) {
+	for i, w := range words {
+		if !IsCanonical(w) {
+			return nil, fmt.Errorf("word %d (%d) is not a valid raw value: must be less than %d", i, w, P)
+		}
+	}
+	return Uint64sAsElements(words), nil
+}
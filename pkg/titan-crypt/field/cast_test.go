@@ -0,0 +1,53 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestElementUint64Casts(t *testing.T) {
+	rng := rand.New(rand.NewSource(520))
+	elements := make([]Element, 100)
+	for i := range elements {
+		elements[i] = New(rng.Uint64())
+	}
+
+	words := ElementsAsUint64s(elements)
+	if len(words) != len(elements) {
+		t.Fatalf("got %d words for %d elements", len(words), len(elements))
+	}
+	for i, w := range words {
+		if w != elements[i].RawValue() {
+			t.Fatalf("word %d = %d, want raw value %d", i, w, elements[i].RawValue())
+		}
+	}
+
+	// The views alias each other.
+	words[3] = One.RawValue()
+	if !elements[3].IsOne() {
+		t.Error("writing through the []uint64 view did not change the element")
+	}
+
+	back, err := Uint64sAsElementsChecked(words)
+	if err != nil {
+		t.Fatalf("Uint64sAsElementsChecked failed: %v", err)
+	}
+	back[5] = Zero
+	if words[5] != 0 || !elements[5].IsZero() {
+		t.Error("writing through the []Element view did not change the words")
+	}
+
+	if len(ElementsAsUint64s(nil)) != 0 || len(Uint64sAsElements(nil)) != 0 {
+		t.Error("casting empty slices should yield empty slices")
+	}
+}
+
+func TestUint64sAsElementsCheckedRejectsNonCanonical(t *testing.T) {
+	words := []uint64{0, P - 1, P, 5}
+	if _, err := Uint64sAsElementsChecked(words); err == nil {
+		t.Error("expected an error for the word P")
+	}
+	if _, err := Uint64sAsElementsChecked([]uint64{^uint64(0)}); err == nil {
+		t.Error("expected an error for 2^64 - 1")
+	}
+}
@@ -0,0 +1,61 @@
+package field
+
+import (
+	"fmt"
+)
+
+// CosetOffset returns the standard offset for shifted evaluation domains: the
+// multiplicative generator 7, as used by twenty-first's ArithmeticDomain. It lies
+// outside every subgroup of order 2^k, so the coset offset·⟨ω⟩ never meets the
+// trace domain ⟨ω⟩.
+func CosetOffset() Element {
+	return Generator()
+}
+
+// CosetDomain is the evaluation domain {offset·ωⁱ : 0 ≤ i < Length}, where
+// Generator = ω is the primitive root of unity of order Length.
+// This is equivalent to twenty-first's ArithmeticDomain.
+type CosetDomain struct {
+	Offset    Element
+	Generator Element
+	Length    uint64
+}
+
+// NewCosetDomain returns the domain of the given length shifted by offset.
+// Use One as offset for the subgroup itself and CosetOffset() for the standard coset.
+//
+// Returns an error if length is not a power of 2 or exceeds 2^32.
+func NewCosetDomain(length uint64, offset Element) (CosetDomain, error) {
+	root, err := GetPrimitiveRoot(length)
+	if err != nil {
+		return CosetDomain{}, fmt.Errorf("invalid coset domain length: %w", err)
+	}
+	return CosetDomain{Offset: offset, Generator: root, Length: length}, nil
+}
+
+// Element returns offset·ω^i. The index is taken modulo Length.
+func (d CosetDomain) Element(i uint64) Element {
+	return d.Offset.Mul(d.Generator.ModPow(i % d.Length))
+}
+
+// Elements returns all domain points in order: offset, offset·ω, offset·ω², ...
+func (d CosetDomain) Elements() []Element {
+	points := make([]Element, d.Length)
+	point := d.Offset
+	for i := range points {
+		points[i] = point
+		point = point.Mul(d.Generator)
+	}
+	return points
+}
+
+// Halve returns the domain of half the length with offset² and generator ω², the
+// image of d under x ↦ x², as used in each round of FRI folding.
+//
+// Returns an error if d has length 1.
+func (d CosetDomain) Halve() (CosetDomain, error) {
+	if d.Length < 2 {
+		return CosetDomain{}, fmt.Errorf("cannot halve a coset domain of length %d", d.Length)
+	}
+	return CosetDomain{Offset: d.Offset.Square(), Generator: d.Generator.Square(), Length: d.Length / 2}, nil
+}
@@ -0,0 +1,64 @@
+package field
+
+import (
+	"testing"
+)
+
+func TestCosetDomainElements(t *testing.T) {
+	for _, length := range []uint64{1, 2, 16, 256} {
+		d, err := NewCosetDomain(length, CosetOffset())
+		if err != nil {
+			t.Fatalf("NewCosetDomain(%d) failed: %v", length, err)
+		}
+
+		want := cosetPoints(length, CosetOffset())
+		got := d.Elements()
+		for i := range want {
+			if !got[i].Equal(want[i]) || !d.Element(uint64(i)).Equal(want[i]) {
+				t.Fatalf("length %d: point %d = %v / %v, want %v", length, i, got[i], d.Element(uint64(i)), want[i])
+			}
+			// The coset does not meet the subgroup.
+			if got[i].ModPow(length).IsOne() {
+				t.Fatalf("length %d: coset point %d lies in the subgroup", length, i)
+			}
+		}
+		if !d.Element(length + 1).Equal(d.Element(1)) {
+			t.Errorf("length %d: Element does not wrap modulo the length", length)
+		}
+	}
+}
+
+func TestCosetDomainHalve(t *testing.T) {
+	d, _ := NewCosetDomain(64, CosetOffset())
+	half, err := d.Halve()
+	if err != nil {
+		t.Fatalf("Halve failed: %v", err)
+	}
+	if half.Length != 32 {
+		t.Fatalf("halved length = %d, want 32", half.Length)
+	}
+
+	points := d.Elements()
+	for i, got := range half.Elements() {
+		if want := points[i].Square(); !got.Equal(want) {
+			t.Fatalf("halved point %d = %v, want %v", i, got, want)
+		}
+		// x and -x = x·ω^(n/2) have the same square
+		if !points[i+32].Square().Equal(got) {
+			t.Fatalf("point %d and its negation square differently", i)
+		}
+	}
+
+	single, _ := NewCosetDomain(1, One)
+	if _, err := single.Halve(); err == nil {
+		t.Error("halving a domain of length 1 should fail")
+	}
+}
+
+func TestNewCosetDomainErrors(t *testing.T) {
+	for _, length := range []uint64{0, 3, 1 << 33} {
+		if _, err := NewCosetDomain(length, One); err == nil {
+			t.Errorf("NewCosetDomain(%d) should fail", length)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package field
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// CRTReconstruct returns the unique x in [0, m₀·m₁·…) with x ≡ residues[i] (mod moduli[i])
+// for every i, by incremental Chinese remaindering over big.Int.
+//
+// The moduli must be pairwise coprime and greater than 1, and every residue must be
+// smaller than its modulus. Typical use is multi-modular multiplication: run the same
+// NTT product modulo several NTT-friendly primes, then reconstruct each coefficient.
+func CRTReconstruct(residues []uint64, moduli []uint64) (*big.Int, error) {
+	if len(residues) != len(moduli) {
+		return nil, fmt.Errorf("got %d residues for %d moduli", len(residues), len(moduli))
+	}
+	if len(moduli) == 0 {
+		return nil, fmt.Errorf("at least one modulus is required")
+	}
+
+	mods := make([]*big.Int, len(moduli))
+	for i, m := range moduli {
+		if m < 2 {
+			return nil, fmt.Errorf("modulus %d must be greater than 1, got %d", i, m)
+		}
+		if residues[i] >= m {
+			return nil, fmt.Errorf("residue %d (%d) is not reduced modulo %d", i, residues[i], m)
+		}
+		mods[i] = new(big.Int).SetUint64(m)
+	}
+
+	gcd := new(big.Int)
+	for i := range mods {
+		for j := i + 1; j < len(mods); j++ {
+			if gcd.GCD(nil, nil, mods[i], mods[j]).Cmp(big.NewInt(1)) != 0 {
+				return nil, fmt.Errorf("moduli %d and %d are not coprime (gcd %s)", moduli[i], moduli[j], gcd)
+			}
+		}
+	}
+
+	// Invariant: x ≡ residues[k] (mod moduli[k]) for all k < i, and 0 <= x < product
+	x := new(big.Int).SetUint64(residues[0])
+	product := new(big.Int).Set(mods[0])
+	diff := new(big.Int)
+	inv := new(big.Int)
+	for i := 1; i < len(mods); i++ {
+		// Solve x + product·t ≡ r (mod m) for t
+		diff.SetUint64(residues[i])
+		diff.Sub(diff, x)
+		diff.Mod(diff, mods[i])
+		inv.ModInverse(inv.Mod(product, mods[i]), mods[i])
+		diff.Mul(diff, inv)
+		diff.Mod(diff, mods[i])
+
+		x.Add(x, diff.Mul(diff, product))
+		product.Mul(product, mods[i])
+	}
+
+	return x, nil
+}
+
+// CRTReconstructElements is CRTReconstruct for residues produced by parallel runs
+// that carry their results as field elements: residues[i].Value() is taken as the
+// residue modulo moduli[i]. Each canonical value must be smaller than its modulus.
+func CRTReconstructElements(residues []Element, moduli []uint64) (*big.Int, error) {
+	values := make([]uint64, len(residues))
+	for i, r := range residues {
+		values[i] = r.Value()
+	}
+	return CRTReconstruct(values, moduli)
+}
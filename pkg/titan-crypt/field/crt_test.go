@@ -0,0 +1,84 @@
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestCRTReconstructSmallPrimes(t *testing.T) {
+	// 97 · 101 = 9797; reconstruct every value in range
+	moduli := []uint64{97, 101}
+	for x := uint64(0); x < 97*101; x += 37 {
+		got, err := CRTReconstruct([]uint64{x % 97, x % 101}, moduli)
+		if err != nil {
+			t.Fatalf("CRTReconstruct failed: %v", err)
+		}
+		if got.Uint64() != x {
+			t.Errorf("CRTReconstruct(%d mod 97, %d mod 101) = %s, want %d", x%97, x%101, got, x)
+		}
+	}
+}
+
+func TestCRTReconstructProduct(t *testing.T) {
+	// A product of two 64-bit values exceeds every single modulus but not their product
+	rng := rand.New(rand.NewSource(390))
+	moduli := []uint64{P, 0xFFFFFFFFFFFFFFC5, 0x7FFFFFFFFFFFFFFF} // Goldilocks, largest 64-bit prime, 2^63-1
+
+	for i := 0; i < 50; i++ {
+		a := new(big.Int).SetUint64(rng.Uint64())
+		b := new(big.Int).SetUint64(rng.Uint64())
+		want := new(big.Int).Mul(a, b)
+
+		residues := make([]uint64, len(moduli))
+		for j, m := range moduli {
+			residues[j] = new(big.Int).Mod(want, new(big.Int).SetUint64(m)).Uint64()
+		}
+
+		got, err := CRTReconstruct(residues, moduli)
+		if err != nil {
+			t.Fatalf("CRTReconstruct failed: %v", err)
+		}
+		if got.Cmp(want) != 0 {
+			t.Errorf("CRTReconstruct = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestCRTReconstructElements(t *testing.T) {
+	moduli := []uint64{97, 101, 103}
+	want := big.NewInt(123456)
+
+	residues := make([]Element, len(moduli))
+	for i, m := range moduli {
+		residues[i] = New(want.Uint64() % m)
+	}
+
+	got, err := CRTReconstructElements(residues, moduli)
+	if err != nil {
+		t.Fatalf("CRTReconstructElements failed: %v", err)
+	}
+	if got.Cmp(want) != 0 {
+		t.Errorf("CRTReconstructElements = %s, want %s", got, want)
+	}
+}
+
+func TestCRTReconstructErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		residues []uint64
+		moduli   []uint64
+	}{
+		{"length mismatch", []uint64{1}, []uint64{3, 5}},
+		{"empty", nil, nil},
+		{"modulus one", []uint64{0, 1}, []uint64{1, 5}},
+		{"not coprime", []uint64{1, 1}, []uint64{6, 9}},
+		{"unreduced residue", []uint64{7, 1}, []uint64{5, 7}},
+	}
+
+	for _, tt := range tests {
+		if _, err := CRTReconstruct(tt.residues, tt.moduli); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
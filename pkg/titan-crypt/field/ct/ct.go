@@ -0,0 +1,73 @@
+// Package ct provides constant-time arithmetic on field elements for use with
+// secret data. None of its functions branch on, or index memory by, the values
+// of their operands.
+//
+// The methods of field.Element are written for speed and several are
+// variable-time in their operands:
+//   - Add and Neg branch on the result or on zero.
+//   - Inverse and InverseConstantTime branch on (and panic for) zero, and
+//     InverseViaGCD runs a data-dependent loop.
+//   - ModPow branches on the bits of the exponent.
+//   - Equal, IsZero, Less and Greater return a bool that callers then branch on.
+//   - String, ElementFromString and the encoding methods are variable-time.
+//
+// Sub, Mul, Square, CondNeg and PowInv are branch-free, with either reduction
+// backend (including the barrett build tag), and are used here as is.
+// Constant-time guarantees hold for the generated code of the gc compiler on
+// 64-bit targets; bits.Add64, bits.Sub64 and bits.Mul64 compile to carry-flag and
+// widening-multiply instructions there.
+package ct
+
+import (
+	"math/bits"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// Add returns a + b.
+func Add(a, b field.Element) field.Element {
+	// a + b = a - (P - b); add P back on borrow, selected with a mask.
+	// For b = 0, P - b = P and the borrow restores a exactly.
+	x, borrow := bits.Sub64(a.RawValue(), field.P-b.RawValue(), 0)
+	return field.NewFromRaw(x + (field.P & -borrow))
+}
+
+// Sub returns a - b.
+func Sub(a, b field.Element) field.Element {
+	x, borrow := bits.Sub64(a.RawValue(), b.RawValue(), 0)
+	return field.NewFromRaw(x + (field.P & -borrow))
+}
+
+// Neg returns -a.
+func Neg(a field.Element) field.Element {
+	return Sub(field.Zero, a)
+}
+
+// Mul returns a · b. Montgomery multiplication has no data-dependent branches.
+func Mul(a, b field.Element) field.Element {
+	return a.Mul(b)
+}
+
+// Inverse returns a^(P-2) through a fixed addition chain: 1/a for nonzero a and
+// Zero for zero, without distinguishing the two cases.
+func Inverse(a field.Element) field.Element {
+	return field.PowInv(a)
+}
+
+// Equal returns 1 if a == b and 0 otherwise.
+func Equal(a, b field.Element) int {
+	d := a.RawValue() ^ b.RawValue()
+	// (d | -d) has its top bit set iff d != 0
+	return int(1 ^ ((d | -d) >> 63))
+}
+
+// IsZero returns 1 if a is zero and 0 otherwise.
+func IsZero(a field.Element) int {
+	return Equal(a, field.Zero)
+}
+
+// Select returns a if cond == 1 and b if cond == 0. Only the lowest bit of cond is used.
+func Select(cond int, a, b field.Element) field.Element {
+	mask := -uint64(cond & 1)
+	return field.NewFromRaw(b.RawValue() ^ ((a.RawValue() ^ b.RawValue()) & mask))
+}
@@ -0,0 +1,71 @@
+package ct
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func testElements() []field.Element {
+	rng := rand.New(rand.NewSource(504))
+	elems := []field.Element{field.Zero, field.One, field.Max, field.New(field.P - 2), field.New(1 << 32)}
+	for i := 0; i < 50; i++ {
+		elems = append(elems, field.New(rng.Uint64()))
+	}
+	return elems
+}
+
+func TestArithmeticMatchesField(t *testing.T) {
+	elems := testElements()
+	for _, a := range elems {
+		for _, b := range elems {
+			if got := Add(a, b); !got.Equal(a.Add(b)) {
+				t.Fatalf("Add(%v, %v) = %v, want %v", a, b, got, a.Add(b))
+			}
+			if got := Sub(a, b); !got.Equal(a.Sub(b)) {
+				t.Fatalf("Sub(%v, %v) = %v, want %v", a, b, got, a.Sub(b))
+			}
+			if got := Mul(a, b); !got.Equal(a.Mul(b)) {
+				t.Fatalf("Mul(%v, %v) = %v, want %v", a, b, got, a.Mul(b))
+			}
+			if got, want := Equal(a, b), a.Equal(b); (got == 1) != want || (got != 0 && got != 1) {
+				t.Fatalf("Equal(%v, %v) = %d, want %v", a, b, got, want)
+			}
+			if got := Select(1, a, b); !got.Equal(a) {
+				t.Fatalf("Select(1, %v, %v) = %v", a, b, got)
+			}
+			if got := Select(0, a, b); !got.Equal(b) {
+				t.Fatalf("Select(0, %v, %v) = %v", a, b, got)
+			}
+		}
+
+		if got := Neg(a); !got.Equal(a.Neg()) {
+			t.Fatalf("Neg(%v) = %v, want %v", a, got, a.Neg())
+		}
+		if a.IsZero() {
+			if !Inverse(a).IsZero() || IsZero(a) != 1 {
+				t.Fatal("Inverse(0) should be 0 and IsZero(0) should be 1")
+			}
+			continue
+		}
+		if got := Inverse(a); !got.Equal(a.Inverse()) {
+			t.Fatalf("Inverse(%v) = %v, want %v", a, got, a.Inverse())
+		}
+		if IsZero(a) != 0 {
+			t.Fatalf("IsZero(%v) = 1", a)
+		}
+	}
+}
+
+func TestResultsAreCanonical(t *testing.T) {
+	for _, a := range testElements() {
+		for _, b := range testElements() {
+			for _, r := range []field.Element{Add(a, b), Sub(a, b), Neg(a)} {
+				if !field.IsCanonical(r.RawValue()) {
+					t.Fatalf("non-canonical raw value %d", r.RawValue())
+				}
+			}
+		}
+	}
+}
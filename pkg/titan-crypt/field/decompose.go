@@ -0,0 +1,32 @@
+package field
+
+import (
+	"fmt"
+)
+
+// DecomposeBase returns the little-endian base-b digits of the canonical value of e,
+// padded with zeros to exactly numLimbs digits, so that e = Σ digits[i]·bⁱ.
+//
+// Returns an error if b < 2, numLimbs is negative, or the value needs more than
+// numLimbs digits.
+func (e Element) DecomposeBase(b uint64, numLimbs int) ([]Element, error) {
+	if b < 2 {
+		return nil, fmt.Errorf("base must be at least 2, got %d", b)
+	}
+	if numLimbs < 0 {
+		return nil, fmt.Errorf("number of limbs cannot be negative, got %d", numLimbs)
+	}
+
+	value := e.Value()
+	digits := make([]Element, numLimbs)
+	for i := range digits {
+		digits[i] = New(value % b)
+		value /= b
+	}
+
+	if value != 0 {
+		return nil, fmt.Errorf("%v does not fit in %d base-%d limbs", e, numLimbs, b)
+	}
+
+	return digits, nil
+}
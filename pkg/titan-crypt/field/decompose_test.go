@@ -0,0 +1,85 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func recompose(digits []Element, b uint64) Element {
+	acc := Zero
+	for i := len(digits) - 1; i >= 0; i-- {
+		acc = acc.Mul(New(b)).Add(digits[i])
+	}
+	return acc
+}
+
+func TestDecomposeBase(t *testing.T) {
+	tests := []struct {
+		value    uint64
+		base     uint64
+		numLimbs int
+		want     []uint64
+	}{
+		{0, 10, 3, []uint64{0, 0, 0}},
+		{12345, 10, 5, []uint64{5, 4, 3, 2, 1}},
+		{12345, 10, 7, []uint64{5, 4, 3, 2, 1, 0, 0}},
+		{0x0123456789ABCDEF, 1 << 16, 4, []uint64{0xCDEF, 0x89AB, 0x4567, 0x0123}},
+		{P - 1, 1 << 16, 4, []uint64{0x0000, 0x0000, 0xFFFF, 0xFFFF}},
+		{P - 1, 1 << 32, 2, []uint64{0, 0xFFFFFFFF}},
+		{5, 2, 3, []uint64{1, 0, 1}},
+		{0, 7, 0, []uint64{}},
+	}
+
+	for _, tt := range tests {
+		e := New(tt.value)
+		digits, err := e.DecomposeBase(tt.base, tt.numLimbs)
+		if err != nil {
+			t.Fatalf("DecomposeBase(%d, base %d, %d limbs) failed: %v", tt.value, tt.base, tt.numLimbs, err)
+		}
+		if len(digits) != len(tt.want) {
+			t.Fatalf("DecomposeBase(%d, base %d) returned %d digits, want %d", tt.value, tt.base, len(digits), len(tt.want))
+		}
+		for i, want := range tt.want {
+			if digits[i].Value() != want {
+				t.Errorf("DecomposeBase(%d, base %d)[%d] = %d, want %d", tt.value, tt.base, i, digits[i].Value(), want)
+			}
+		}
+		if got := recompose(digits, tt.base); !got.Equal(e) {
+			t.Errorf("recomposition of %d in base %d = %v", tt.value, tt.base, got)
+		}
+	}
+}
+
+func TestDecomposeBaseRecomposesRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(398))
+	for i := 0; i < 1000; i++ {
+		e := New(rng.Uint64())
+		for _, base := range []uint64{2, 3, 10, 1 << 16, 1 << 32} {
+			digits, err := e.DecomposeBase(base, 64)
+			if err != nil {
+				t.Fatalf("DecomposeBase failed: %v", err)
+			}
+			if got := recompose(digits, base); !got.Equal(e) {
+				t.Fatalf("recomposition of %v in base %d = %v", e, base, got)
+			}
+		}
+	}
+}
+
+func TestDecomposeBaseErrors(t *testing.T) {
+	if _, err := New(12345).DecomposeBase(10, 4); err == nil {
+		t.Error("expected overflow error for 12345 in 4 decimal limbs")
+	}
+	if _, err := New(1<<16).DecomposeBase(1<<16, 1); err == nil {
+		t.Error("expected overflow error for 2^16 in one base-2^16 limb")
+	}
+	if _, err := New(1).DecomposeBase(10, 0); err == nil {
+		t.Error("expected overflow error for nonzero value in zero limbs")
+	}
+	if _, err := New(1).DecomposeBase(1, 64); err == nil {
+		t.Error("expected error for base 1")
+	}
+	if _, err := New(1).DecomposeBase(10, -1); err == nil {
+		t.Error("expected error for negative limb count")
+	}
+}
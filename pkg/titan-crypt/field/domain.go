@@ -0,0 +1,130 @@
+package field
+
+import (
+	"fmt"
+)
+
+// QuotientOnCoset divides numerator evaluations by the vanishing polynomial
+// Z_H(X) = X^order - 1 of the subgroup H of the given order.
+//
+// numerEvals[i] must be the numerator evaluated at offset·ωⁱ, where ω is the primitive
+// root of unity of order len(numerEvals). Since Z_H(offset·ωⁱ) = offset^order·ω^(i·order) - 1
+// only takes len(numerEvals)/order distinct values, those are computed once and
+// batch-inverted. If the numerator is divisible by Z_H, the result holds the
+// evaluations of the quotient on the same coset.
+//
+// Returns an error if len(numerEvals) or order is not a power of 2, if order exceeds
+// len(numerEvals), or if the coset intersects H (Z_H would vanish on it).
+func QuotientOnCoset(numerEvals []Element, order uint64, offset Element) ([]Element, error) {
+	n := uint64(len(numerEvals))
+	if n == 0 || n&(n-1) != 0 {
+		return nil, fmt.Errorf("number of evaluations must be a power of 2, got %d", n)
+	}
+	if order == 0 || order&(order-1) != 0 {
+		return nil, fmt.Errorf("order must be a power of 2, got %d", order)
+	}
+	if order > n {
+		return nil, fmt.Errorf("order %d exceeds the coset size %d", order, n)
+	}
+
+	vanishing, err := vanishingPeriod(order, n, offset)
+	if err != nil {
+		return nil, err
+	}
+	for _, z := range vanishing {
+		if z.IsZero() {
+			return nil, fmt.Errorf("vanishing polynomial of order %d is zero on the coset with offset %v", order, offset)
+		}
+	}
+	vanishingInv := BatchInverse(vanishing)
+
+	quotient := make([]Element, n)
+	for i, e := range numerEvals {
+		quotient[i] = e.Mul(vanishingInv[i%len(vanishingInv)])
+	}
+
+	return quotient, nil
+}
+
+// VanishingOnCoset evaluates Z_H(X) = X^order - 1 at every point offset·ωⁱ of the
+// coset of size cosetOrder, where ω is the primitive root of unity of that order.
+//
+// Since (offset·ωⁱ)^order = offset^order·(ω^order)ⁱ and ω^order has order
+// cosetOrder/order, the values repeat with that period. Only one exponentiation
+// and one multiplication per distinct value are needed; the rest are copies.
+//
+// Returns an error if order or cosetOrder is not a power of 2.
+func VanishingOnCoset(order uint64, cosetOrder uint64, offset Element) ([]Element, error) {
+	if order == 0 || order&(order-1) != 0 {
+		return nil, fmt.Errorf("order must be a power of 2, got %d", order)
+	}
+	if cosetOrder == 0 || cosetOrder&(cosetOrder-1) != 0 {
+		return nil, fmt.Errorf("coset order must be a power of 2, got %d", cosetOrder)
+	}
+
+	period, err := vanishingPeriod(order, cosetOrder, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]Element, cosetOrder)
+	for i := 0; i < len(values); i += len(period) {
+		copy(values[i:], period)
+	}
+	return values, nil
+}
+
+// vanishingPeriod returns the distinct values of X^order - 1 on the coset
+// offset·⟨ω⟩ of size cosetOrder, in coset order. Both orders must be powers of 2.
+func vanishingPeriod(order uint64, cosetOrder uint64, offset Element) ([]Element, error) {
+	period := uint64(1)
+	if cosetOrder > order {
+		period = cosetOrder / order
+	}
+
+	periodRoot, err := GetPrimitiveRoot(period)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]Element, period)
+	shift := offset.ModPow(order)
+	for i := range values {
+		values[i] = shift.Sub(One)
+		shift = shift.Mul(periodRoot)
+	}
+	return values, nil
+}
+
+// IsCanonicalHalf reports whether x is the representative FRI folding keeps from
+// the pair {x, -x}: the one with the smaller canonical value. On a subgroup of
+// even order both members of each pair lie in the domain, so exactly one of them
+// is canonical and the canonical points form half the domain.
+//
+// Since P is odd, no nonzero element is its own negation; Zero (which is never a
+// domain point) is classified as canonical. For order < 2 the domain is {1}, which
+// contains no pairs, and every x is reported as canonical.
+func IsCanonicalHalf(x Element, order uint64) bool {
+	if order < 2 {
+		return true
+	}
+	return x.Value() <= x.Neg().Value()
+}
+
+// RestrictToSubgroup restricts evaluations on a domain of size 2n to its subgroup
+// of size n. evals[i] is taken to be the value at ωⁱ, where ω is the primitive root
+// of unity of order len(evals); since ω² generates the subgroup, result[i] = evals[2i]
+// is the value at (ω²)ⁱ, the i-th point of the half-size domain in NTT order.
+//
+// Panics if len(evals) is odd.
+func RestrictToSubgroup(evals []Element) []Element {
+	if len(evals)%2 != 0 {
+		panic(fmt.Sprintf("cannot restrict a domain of odd size %d", len(evals)))
+	}
+
+	result := make([]Element, len(evals)/2)
+	for i := range result {
+		result[i] = evals[2*i]
+	}
+	return result
+}
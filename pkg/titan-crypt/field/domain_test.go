@@ -0,0 +1,180 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// evaluateCoeffs evaluates the polynomial with the given coefficients at x using Horner's rule.
+func evaluateCoeffs(coeffs []Element, x Element) Element {
+	acc := Zero
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		acc = acc.Mul(x).Add(coeffs[i])
+	}
+	return acc
+}
+
+// cosetPoints returns offset·ωⁱ for the primitive root ω of order n.
+func cosetPoints(n uint64, offset Element) []Element {
+	root, _ := GetPrimitiveRoot(n)
+	points := make([]Element, n)
+	point := offset
+	for i := range points {
+		points[i] = point
+		point = point.Mul(root)
+	}
+	return points
+}
+
+func TestQuotientOnCoset(t *testing.T) {
+	rng := rand.New(rand.NewSource(395))
+	const n = 64
+	offset := Generator()
+	points := cosetPoints(n, offset)
+
+	for _, order := range []uint64{1, 4, 16, 64} {
+		// numerator = Z_H · q for a random q of degree n - order - 1
+		q := make([]Element, n-order)
+		for i := range q {
+			q[i] = New(rng.Uint64())
+		}
+
+		numerEvals := make([]Element, n)
+		vanishingEvals := make([]Element, n)
+		for i, x := range points {
+			vanishingEvals[i] = x.ModPow(order).Sub(One)
+			numerEvals[i] = vanishingEvals[i].Mul(evaluateCoeffs(q, x))
+		}
+
+		quotient, err := QuotientOnCoset(numerEvals, order, offset)
+		if err != nil {
+			t.Fatalf("order %d: QuotientOnCoset failed: %v", order, err)
+		}
+
+		for i, x := range points {
+			if got := quotient[i].Mul(vanishingEvals[i]); !got.Equal(numerEvals[i]) {
+				t.Fatalf("order %d: quotient·Z_H at %d = %v, want %v", order, i, got, numerEvals[i])
+			}
+			if want := evaluateCoeffs(q, x); !quotient[i].Equal(want) {
+				t.Fatalf("order %d: quotient[%d] = %v, want q(x) = %v", order, i, quotient[i], want)
+			}
+		}
+	}
+}
+
+func TestQuotientOnCosetErrors(t *testing.T) {
+	evals := make([]Element, 16)
+
+	if _, err := QuotientOnCoset(evals[:12], 4, Generator()); err == nil {
+		t.Error("expected error for non-power-of-2 evaluation count")
+	}
+	if _, err := QuotientOnCoset(evals, 3, Generator()); err == nil {
+		t.Error("expected error for non-power-of-2 order")
+	}
+	if _, err := QuotientOnCoset(evals, 32, Generator()); err == nil {
+		t.Error("expected error for order larger than the coset")
+	}
+
+	// Offset 1 makes the coset the subgroup itself, where Z_H vanishes
+	if _, err := QuotientOnCoset(evals, 4, One); err == nil {
+		t.Error("expected error when the coset intersects H")
+	}
+}
+
+func TestVanishingOnCoset(t *testing.T) {
+	rng := rand.New(rand.NewSource(407))
+	offsets := []Element{One, Generator(), New(rng.Uint64())}
+
+	for _, cosetOrder := range []uint64{1, 2, 16, 256} {
+		for _, order := range []uint64{1, 4, 16, 512} {
+			for _, offset := range offsets {
+				got, err := VanishingOnCoset(order, cosetOrder, offset)
+				if err != nil {
+					t.Fatalf("VanishingOnCoset(%d, %d) failed: %v", order, cosetOrder, err)
+				}
+				if uint64(len(got)) != cosetOrder {
+					t.Fatalf("got %d values, want %d", len(got), cosetOrder)
+				}
+
+				for i, x := range cosetPoints(cosetOrder, offset) {
+					if want := x.ModPow(order).Sub(One); !got[i].Equal(want) {
+						t.Fatalf("order %d, coset %d, offset %v: value[%d] = %v, want %v",
+							order, cosetOrder, offset, i, got[i], want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestVanishingOnCosetErrors(t *testing.T) {
+	if _, err := VanishingOnCoset(0, 16, Generator()); err == nil {
+		t.Error("expected error for order 0")
+	}
+	if _, err := VanishingOnCoset(3, 16, Generator()); err == nil {
+		t.Error("expected error for non-power-of-2 order")
+	}
+	if _, err := VanishingOnCoset(4, 12, Generator()); err == nil {
+		t.Error("expected error for non-power-of-2 coset order")
+	}
+}
+
+func TestIsCanonicalHalf(t *testing.T) {
+	for _, order := range []uint64{2, 4, 64, 1024} {
+		points := cosetPoints(order, One)
+
+		canonical := 0
+		for _, x := range points {
+			a, b := IsCanonicalHalf(x, order), IsCanonicalHalf(x.Neg(), order)
+			if a == b {
+				t.Fatalf("order %d: x = %v and -x both report %v", order, x, a)
+			}
+			if a {
+				canonical++
+			}
+		}
+		if uint64(canonical) != order/2 {
+			t.Errorf("order %d: %d canonical points, want %d", order, canonical, order/2)
+		}
+	}
+}
+
+func TestIsCanonicalHalfEdgeCases(t *testing.T) {
+	if !IsCanonicalHalf(Zero, 16) {
+		t.Error("Zero must be classified deterministically as canonical")
+	}
+	if !IsCanonicalHalf(One, 16) || IsCanonicalHalf(Max, 16) {
+		t.Error("1 must be canonical and -1 must not")
+	}
+	if !IsCanonicalHalf(One, 1) {
+		t.Error("the trivial domain has no pairs; its point must be canonical")
+	}
+}
+
+func TestDomainHelpersOrderOne(t *testing.T) {
+	offset := Generator()
+
+	powers, err := DefaultRootProvider.RootPowers(1)
+	if err != nil || len(powers) != 1 || !powers[0].IsOne() {
+		t.Errorf("RootPowers(1) = %v, %v; want [1]", powers, err)
+	}
+	if root := PrimitiveRootOfUnity(1); !root.IsOne() {
+		t.Errorf("PrimitiveRootOfUnity(1) = %v, want 1", root)
+	}
+
+	vanishing, err := VanishingOnCoset(1, 1, offset)
+	if err != nil || len(vanishing) != 1 || !vanishing[0].Equal(offset.Sub(One)) {
+		t.Errorf("VanishingOnCoset(1, 1) = %v, %v; want [offset - 1]", vanishing, err)
+	}
+
+	numer := New(99)
+	quotient, err := QuotientOnCoset([]Element{numer}, 1, offset)
+	if err != nil || len(quotient) != 1 || !quotient[0].Mul(offset.Sub(One)).Equal(numer) {
+		t.Errorf("QuotientOnCoset of size 1 = %v, %v", quotient, err)
+	}
+
+	restricted := RestrictToSubgroup([]Element{numer, offset})
+	if len(restricted) != 1 || !restricted[0].Equal(numer) {
+		t.Errorf("RestrictToSubgroup of size 2 = %v, want [%v]", restricted, numer)
+	}
+}
@@ -9,6 +9,7 @@
 package field
 
 import (
+	"cmp"
 	"encoding/binary"
 	"fmt"
 	"math/big"
@@ -24,6 +25,18 @@ const R2 uint64 = 0xFFFFFFFE00000001
 // MINUS_TWO_INVERSE is the constant -2^(-1) mod P
 const MINUS_TWO_INVERSE uint64 = 0x7FFFFFFF80000000
 
+// R is the Montgomery radix 2^64 reduced mod P, i.e. 2^32 - 1. An element with
+// canonical value v is stored as v·R mod P; One is stored as R.
+const R uint64 = 0x00000000FFFFFFFF
+
+// GeneratorValue is the canonical value of the multiplicative generator returned
+// by Generator. It generates the whole group of order P - 1.
+const GeneratorValue uint64 = 7
+
+// MaxTwoAdicity is the largest k with 2^k dividing P - 1 = (2^32 - 1)·2^32, so
+// 2^MaxTwoAdicity is the largest power-of-2 NTT domain the field supports.
+const MaxTwoAdicity int = 32
+
 // Element represents a field element in the base field F_p where p = 2^64 - 2^32 + 1.
 // The value is stored in Montgomery representation for efficient arithmetic.
 // This is equivalent to twenty-first's BFieldElement.
@@ -57,6 +70,35 @@ func NewFromRaw(raw uint64) Element {
 	return Element{value: raw}
 }
 
+// ToMontgomery converts a canonical value v < P to its Montgomery form v·2^64 mod P,
+// the representation Element stores; NewFromRaw(ToMontgomery(v)) equals New(v).
+// Element's Mul and Square already operate on this form with montyred (REDC), so
+// raw words are only needed at boundaries such as custom serialization.
+func ToMontgomery(v uint64) uint64 {
+	return montyred(mul128(v, R2))
+}
+
+// FromMontgomery converts a Montgomery-form word back to its canonical value;
+// FromMontgomery(e.RawValue()) equals e.Value().
+func FromMontgomery(raw uint64) uint64 {
+	return montyred(uint128{lo: raw, hi: 0})
+}
+
+// IsCanonical returns true if v is a valid canonical representative, i.e. v < P.
+// Montgomery-form raw values share the same range, so this also validates raw input.
+func IsCanonical(v uint64) bool {
+	return v < P
+}
+
+// NewFromRawChecked is NewFromRaw for untrusted input: it returns an error
+// if raw is not a valid Montgomery-form value.
+func NewFromRawChecked(raw uint64) (Element, error) {
+	if !IsCanonical(raw) {
+		return Zero, fmt.Errorf("raw value %d is not canonical: must be less than %d", raw, P)
+	}
+	return NewFromRaw(raw), nil
+}
+
 // NewFromInt64 creates a new field element from an int64 value.
 // Negative values are handled correctly.
 func NewFromInt64(value int64) Element {
@@ -71,6 +113,26 @@ func NewFromInt64(value int64) Element {
 	return New(uint64(value) % P)
 }
 
+// ToSigned interprets e as a small signed integer: canonical values in [0, bound)
+// map to themselves and values in [P-bound, P) map to value-P, so ToSigned(bound)
+// inverts NewFromInt64 for every v in [-bound, bound).
+//
+// Returns an error if bound > (P-1)/2 (the two bands would overlap) or if e lies
+// outside both bands.
+func (e Element) ToSigned(bound uint64) (int64, error) {
+	if bound > (P-1)/2 {
+		return 0, fmt.Errorf("signed bound %d exceeds (P-1)/2", bound)
+	}
+	v := e.Value()
+	if v < bound {
+		return int64(v), nil
+	}
+	if v >= P-bound {
+		return -int64(P - v), nil
+	}
+	return 0, fmt.Errorf("value %d is outside the signed range [-%d, %d)", v, bound, bound)
+}
+
 // NewFromBigInt creates a new field element from a big.Int value.
 func NewFromBigInt(value *big.Int) Element {
 	// Reduce modulo P
@@ -85,6 +147,19 @@ func NewFromBigInt(value *big.Int) Element {
 	return New(reduced.Uint64())
 }
 
+// NewFromBigIntChecked is NewFromBigInt for values that must already be
+// canonical: it returns an error instead of reducing if value is nil, negative
+// or at least P. Use NewFromBigInt to reduce arbitrary integers modulo P.
+func NewFromBigIntChecked(value *big.Int) (Element, error) {
+	if value == nil {
+		return Zero, fmt.Errorf("nil big.Int")
+	}
+	if value.Sign() < 0 || !value.IsUint64() || !IsCanonical(value.Uint64()) {
+		return Zero, fmt.Errorf("big.Int %s is not a canonical field value: must be in [0, %d)", value, P)
+	}
+	return New(value.Uint64()), nil
+}
+
 // Value returns the canonical uint64 value of the field element.
 // This converts from Montgomery form back to normal form.
 //
@@ -168,7 +243,8 @@ func (e Element) Square() Element {
 }
 
 // Inverse computes the multiplicative inverse: a^(-1) mod P
-// Uses the optimized inversion chain from twenty-first.
+// Uses the optimized inversion chain from twenty-first, i.e. it is InverseConstantTime;
+// compare BenchmarkElementInverse with BenchmarkInverseViaGCD.
 //
 // This is equivalent to twenty-first's inverse() implementation.
 func (e Element) Inverse() Element {
@@ -176,29 +252,7 @@ func (e Element) Inverse() Element {
 		panic("attempted to find the multiplicative inverse of zero")
 	}
 
-	// Helper function for repeated squaring
-	exp := func(base Element, exponent uint64) Element {
-		result := base
-		for i := uint64(0); i < exponent; i++ {
-			result = result.Square()
-		}
-		return result
-	}
-
-	// Optimized inversion chain from twenty-first
-	// This computes a^(P-2) mod P using an addition chain
-	x := e
-	bin2Ones := x.Square().Mul(x)                  // a^3
-	bin3Ones := bin2Ones.Square().Mul(x)           // a^7
-	bin6Ones := exp(bin3Ones, 3).Mul(bin3Ones)     // a^63
-	bin12Ones := exp(bin6Ones, 6).Mul(bin6Ones)    // a^(2^12 - 1)
-	bin24Ones := exp(bin12Ones, 12).Mul(bin12Ones) // a^(2^24 - 1)
-	bin30Ones := exp(bin24Ones, 6).Mul(bin6Ones)   // a^(2^30 - 1)
-	bin31Ones := bin30Ones.Square().Mul(x)         // a^(2^31 - 1)
-	bin31Ones1Zero := bin31Ones.Square()           // a^(2^32 - 2)
-	bin32Ones := bin31Ones.Square().Mul(x)         // a^(2^32 - 1)
-
-	return exp(bin31Ones1Zero, 32).Mul(bin32Ones)
+	return powInvChain(e)
 }
 
 // ModPow computes modular exponentiation: a^exp mod P
@@ -233,6 +287,15 @@ func (e Element) Neg() Element {
 	return Element{value: P - e.value}
 }
 
+// CondNeg returns -e if cond == 1 and e if cond == 0, without branching on cond or e.
+// Only the lowest bit of cond is used.
+func (e Element) CondNeg(cond int) Element {
+	// Zero.Sub is branch-free, unlike Neg
+	neg := Zero.Sub(e)
+	mask := -uint64(cond & 1)
+	return Element{value: e.value ^ ((e.value ^ neg.value) & mask)}
+}
+
 // Equal returns true if two elements are equal.
 func (e Element) Equal(other Element) bool {
 	return e.value == other.value
@@ -248,46 +311,99 @@ func (e Element) Greater(other Element) bool {
 	return e.Value() > other.Value()
 }
 
+// Cmp compares canonical representations, returning -1 if e < other, 0 if they
+// are equal and +1 if e > other. It has the shape slices.SortFunc and
+// slices.BinarySearchFunc expect.
+func (e Element) Cmp(other Element) int {
+	return cmp.Compare(e.Value(), other.Value())
+}
+
 // ToBigInt converts the field element to a big.Int.
 func (e Element) ToBigInt() *big.Int {
 	return new(big.Int).SetUint64(e.Value())
 }
 
-// ToBytes returns the little-endian byte representation.
+// BigInt returns the canonical value of e as a new big.Int. It is ToBigInt,
+// named to match the math/big accessors (Int.Uint64, Float.Int).
+func (e Element) BigInt() *big.Int {
+	return e.ToBigInt()
+}
+
+// ToBytes returns the little-endian bytes of the raw Montgomery-form value.
+// This is the internal representation (Tip5's split-and-lookup operates on it);
+// use Bytes for the canonical serialized form.
 func (e Element) ToBytes() [8]byte {
 	var bytes [8]byte
 	binary.LittleEndian.PutUint64(bytes[:], e.value)
 	return bytes
 }
 
-// FromBytes creates an element from little-endian bytes.
+// FromBytes creates an element from the little-endian bytes of a raw
+// Montgomery-form value, the inverse of ToBytes.
 func FromBytes(bytes [8]byte) Element {
 	raw := binary.LittleEndian.Uint64(bytes[:])
 	return NewFromRaw(raw)
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (e Element) MarshalBinary() ([]byte, error) {
-	bytes := e.ToBytes()
-	return bytes[:], nil
+// Bytes returns the canonical serialized form of e: its canonical value
+// e.Value() < P as 8 little-endian bytes. Every element has exactly one
+// encoding, so equal elements always serialize identically.
+func (e Element) Bytes() [8]byte {
+	var bytes [8]byte
+	binary.LittleEndian.PutUint64(bytes[:], e.Value())
+	return bytes
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (e *Element) UnmarshalBinary(data []byte) error {
+// SetBytes sets e to the element encoded by data in the form produced by Bytes.
+// Returns an error, leaving e unchanged, if len(data) != 8 or if the encoded
+// value is not canonical (>= P).
+func (e *Element) SetBytes(data []byte) error {
 	if len(data) != 8 {
 		return fmt.Errorf("invalid data length: expected 8 bytes, got %d", len(data))
 	}
 
-	var bytes [8]byte
-	copy(bytes[:], data)
-	*e = FromBytes(bytes)
+	v := binary.LittleEndian.Uint64(data)
+	if !IsCanonical(v) {
+		return fmt.Errorf("value %d is not canonical: must be less than %d", v, P)
+	}
+	*e = New(v)
+	return nil
+}
+
+// SetLegacyBytes sets e from the 8-byte raw Montgomery word that MarshalBinary
+// emitted before it switched to the canonical form of Bytes. It exists only to
+// migrate persisted data: most legacy words are below P, so SetBytes would
+// accept them and silently decode a different element. Returns an error,
+// leaving e unchanged, if len(data) != 8 or if the word is not below P.
+func (e *Element) SetLegacyBytes(data []byte) error {
+	if len(data) != 8 {
+		return fmt.Errorf("invalid data length: expected 8 bytes, got %d", len(data))
+	}
+
+	raw, err := NewFromRawChecked(binary.LittleEndian.Uint64(data))
+	if err != nil {
+		return err
+	}
+	*e = raw
 	return nil
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler using the canonical form of Bytes.
+func (e Element) MarshalBinary() ([]byte, error) {
+	bytes := e.Bytes()
+	return bytes[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It is SetBytes, so
+// non-canonical values are rejected.
+func (e *Element) UnmarshalBinary(data []byte) error {
+	return e.SetBytes(data)
+}
+
 // Generator returns a generator for the entire field.
-// The generator for this field is 7.
+// The generator for this field is GeneratorValue, 7.
 func Generator() Element {
-	return New(7)
+	return New(GeneratorValue)
 }
 
 // uint128 represents a 128-bit unsigned integer.
@@ -300,26 +416,3 @@ func mul128(a, b uint64) uint128 {
 	hi, lo := bits.Mul64(a, b)
 	return uint128{lo: lo, hi: hi}
 }
-
-// montyred performs Montgomery reduction: reduces a 128-bit value modulo P.
-// This is the core operation for efficient modular arithmetic.
-//
-// This is a direct port of twenty-first's montyred() function.
-// See: https://github.com/Neptune-Crypto/twenty-first/pull/70
-func montyred(x uint128) uint64 {
-	xl := x.lo
-	xh := x.hi
-
-	// a = xl + (xl << 32), with overflow detection
-	a, e := bits.Add64(xl, xl<<32, 0)
-
-	// b = a - (a >> 32) - overflow_flag
-	b := a - (a >> 32) - e
-
-	// r = xh - b, with borrow detection
-	r, c := bits.Sub64(xh, b, 0)
-
-	// Final adjustment
-	// This is equivalent to: if c { r + P } else { r }
-	return r - ((1 + ^P) * c)
-}
@@ -1,7 +1,10 @@
 package field
 
 import (
+	"encoding/binary"
 	"math/big"
+	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -199,6 +202,14 @@ func TestElementComparison(t *testing.T) {
 	if b.Greater(a) {
 		t.Error("Greater test failed")
 	}
+
+	// Cmp orders by canonical value, not by the Montgomery representation
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || a.Cmp(c) != 0 {
+		t.Error("Cmp test failed")
+	}
+	if Max.Cmp(Zero) != 1 || One.Cmp(New(2)) != -1 {
+		t.Error("Cmp test failed at the boundaries")
+	}
 }
 
 func TestElementEdgeCases(t *testing.T) {
@@ -220,3 +231,226 @@ func TestElementModularReduction(t *testing.T) {
 		t.Errorf("Modular reduction failed: expected %v, got %v", expected, large)
 	}
 }
+
+func TestElementCondNeg(t *testing.T) {
+	rng := rand.New(rand.NewSource(396))
+
+	values := []Element{Zero, One, Max, New(P / 2)}
+	for i := 0; i < 1000; i++ {
+		values = append(values, New(rng.Uint64()))
+	}
+
+	for _, e := range values {
+		if got := e.CondNeg(0); !got.Equal(e) {
+			t.Errorf("CondNeg(0) of %v = %v, want %v", e, got, e)
+		}
+		if got := e.CondNeg(1); !got.Equal(e.Neg()) {
+			t.Errorf("CondNeg(1) of %v = %v, want %v", e, got, e.Neg())
+		}
+	}
+}
+
+func TestIsCanonical(t *testing.T) {
+	tests := []struct {
+		value uint64
+		want  bool
+	}{
+		{0, true},
+		{1, true},
+		{P - 1, true},
+		{P, false},
+		{P + 1, false},
+		{^uint64(0), false},
+	}
+
+	for _, tt := range tests {
+		if got := IsCanonical(tt.value); got != tt.want {
+			t.Errorf("IsCanonical(%d) = %v, want %v", tt.value, got, tt.want)
+		}
+
+		_, err := NewFromRawChecked(tt.value)
+		if tt.want && err != nil {
+			t.Errorf("NewFromRawChecked(%d) failed: %v", tt.value, err)
+		}
+		if !tt.want && err == nil {
+			t.Errorf("NewFromRawChecked(%d) accepted a non-canonical value", tt.value)
+		}
+	}
+}
+
+func TestElementUnmarshalBinaryRejectsNonCanonical(t *testing.T) {
+	for _, raw := range []uint64{P, P + 1, ^uint64(0)} {
+		var data [8]byte
+		binary.LittleEndian.PutUint64(data[:], raw)
+
+		var e Element
+		if err := e.UnmarshalBinary(data[:]); err == nil {
+			t.Errorf("UnmarshalBinary accepted raw value %d", raw)
+		}
+	}
+
+	var e Element
+	var data [8]byte
+	binary.LittleEndian.PutUint64(data[:], P-1)
+	if err := e.UnmarshalBinary(data[:]); err != nil {
+		t.Errorf("UnmarshalBinary rejected raw value P-1: %v", err)
+	}
+}
+
+func TestElementToSigned(t *testing.T) {
+	const bound = 1 << 20
+	for _, v := range []int64{-1, 0, 1, bound - 1, -bound, -(bound - 1)} {
+		got, err := NewFromInt64(v).ToSigned(bound)
+		if err != nil {
+			t.Fatalf("ToSigned(%d) failed: %v", v, err)
+		}
+		if got != v {
+			t.Errorf("ToSigned(NewFromInt64(%d)) = %d", v, got)
+		}
+	}
+
+	for _, v := range []int64{bound, -bound - 1, 1 << 40} {
+		if _, err := NewFromInt64(v).ToSigned(bound); err == nil {
+			t.Errorf("ToSigned(%d) with bound %d should fail", v, bound)
+		}
+	}
+
+	maxBound := (P - 1) / 2
+	if got, err := Max.ToSigned(maxBound); err != nil || got != -1 {
+		t.Errorf("Max.ToSigned((P-1)/2) = %d, %v; want -1", got, err)
+	}
+	if _, err := One.ToSigned(maxBound + 1); err == nil {
+		t.Error("bound above (P-1)/2 should fail")
+	}
+}
+
+func TestMontgomeryConversions(t *testing.T) {
+	rng := rand.New(rand.NewSource(502))
+	for _, v := range []uint64{0, 1, 2, P - 1, rng.Uint64() % P, rng.Uint64() % P} {
+		raw := ToMontgomery(v)
+		if !NewFromRaw(raw).Equal(New(v)) {
+			t.Errorf("NewFromRaw(ToMontgomery(%d)) != New(%d)", v, v)
+		}
+		if got := FromMontgomery(raw); got != v {
+			t.Errorf("FromMontgomery(ToMontgomery(%d)) = %d", v, got)
+		}
+		if got := FromMontgomery(New(v).RawValue()); got != New(v).Value() {
+			t.Errorf("FromMontgomery(RawValue) = %d, want %d", got, New(v).Value())
+		}
+	}
+}
+
+func TestElementBytesCanonical(t *testing.T) {
+	rng := rand.New(rand.NewSource(508))
+	for _, e := range []Element{Zero, One, Max, New(rng.Uint64()), New(rng.Uint64())} {
+		bytes := e.Bytes()
+		if got := binary.LittleEndian.Uint64(bytes[:]); got != e.Value() {
+			t.Errorf("Bytes(%v) encodes %d, want the canonical value", e, got)
+		}
+
+		var restored Element
+		if err := restored.SetBytes(bytes[:]); err != nil || !restored.Equal(e) {
+			t.Errorf("SetBytes(Bytes(%v)) = %v, %v", e, restored, err)
+		}
+
+		data, err := e.MarshalBinary()
+		if err != nil || !slices.Equal(data, bytes[:]) {
+			t.Errorf("MarshalBinary(%v) = %x, want %x", e, data, bytes)
+		}
+	}
+
+	// One is stored as 2^64 mod P internally but serializes as 1.
+	if bytes := One.Bytes(); bytes != [8]byte{1} {
+		t.Errorf("One.Bytes() = %x, want 0100000000000000", bytes)
+	}
+
+	original := New(5)
+	e := original
+	if err := e.SetBytes([]byte{1, 2, 3}); err == nil {
+		t.Error("SetBytes accepted 3 bytes")
+	}
+	var nonCanonical [8]byte
+	binary.LittleEndian.PutUint64(nonCanonical[:], P)
+	if err := e.SetBytes(nonCanonical[:]); err == nil {
+		t.Error("SetBytes accepted P")
+	}
+	if !e.Equal(original) {
+		t.Errorf("failed SetBytes modified the element to %v", e)
+	}
+}
+
+func TestElementSetLegacyBytes(t *testing.T) {
+	rng := rand.New(rand.NewSource(510))
+	for _, e := range []Element{Zero, One, Max, New(rng.Uint64())} {
+		legacy := e.ToBytes()
+		var restored Element
+		if err := restored.SetLegacyBytes(legacy[:]); err != nil || !restored.Equal(e) {
+			t.Errorf("SetLegacyBytes(ToBytes(%v)) = %v, %v", e, restored, err)
+		}
+	}
+
+	// A legacy blob of One is 2^64 mod P, a valid canonical value that
+	// SetBytes would decode to a different element.
+	legacy := One.ToBytes()
+	var misread Element
+	if err := misread.SetBytes(legacy[:]); err != nil || misread.Equal(One) {
+		t.Errorf("SetBytes(legacy One) = %v, %v; expected a different element", misread, err)
+	}
+
+	original := New(5)
+	e := original
+	if err := e.SetLegacyBytes([]byte{1, 2, 3}); err == nil {
+		t.Error("SetLegacyBytes accepted 3 bytes")
+	}
+	var nonCanonical [8]byte
+	binary.LittleEndian.PutUint64(nonCanonical[:], P)
+	if err := e.SetLegacyBytes(nonCanonical[:]); err == nil {
+		t.Error("SetLegacyBytes accepted P")
+	}
+	if !e.Equal(original) {
+		t.Errorf("failed SetLegacyBytes modified the element to %v", e)
+	}
+}
+
+func TestNewFromBigIntChecked(t *testing.T) {
+	rng := rand.New(rand.NewSource(509))
+	for _, v := range []uint64{0, 1, P - 1, rng.Uint64() % P} {
+		e, err := NewFromBigIntChecked(new(big.Int).SetUint64(v))
+		if err != nil || e.Value() != v {
+			t.Errorf("NewFromBigIntChecked(%d) = %v, %v", v, e, err)
+		}
+		if got := e.BigInt(); got.Cmp(new(big.Int).SetUint64(v)) != 0 {
+			t.Errorf("BigInt() = %s, want %d", got, v)
+		}
+	}
+
+	tooLarge := new(big.Int).Lsh(big.NewInt(1), 70)
+	for _, v := range []*big.Int{nil, big.NewInt(-1), new(big.Int).SetUint64(P), tooLarge} {
+		if _, err := NewFromBigIntChecked(v); err == nil {
+			t.Errorf("NewFromBigIntChecked(%v) should fail", v)
+		}
+	}
+
+	// The unchecked constructor still reduces.
+	if got := NewFromBigInt(new(big.Int).SetUint64(P + 5)); got.Value() != 5 {
+		t.Errorf("NewFromBigInt(P+5) = %v, want 5", got)
+	}
+}
+
+func TestExportedConstants(t *testing.T) {
+	if New(1).RawValue() != R {
+		t.Errorf("One is stored as %d, want R = %d", One.RawValue(), R)
+	}
+	if got := NewFromRaw(R2).Value(); got != R {
+		t.Errorf("R2 is not R² mod P: R2·R⁻¹ = %d, want %d", got, R)
+	}
+	if !New(MINUS_TWO_INVERSE).Mul(New(2)).Equal(Max) {
+		t.Error("MINUS_TWO_INVERSE · 2 != -1")
+	}
+	if !IsPrimitiveRootOfUnity(New(GeneratorValue), P-1) {
+		t.Error("GeneratorValue does not generate the multiplicative group")
+	}
+	if (P-1)%(1<<MaxTwoAdicity) != 0 || ((P-1)>>MaxTwoAdicity)%2 != 1 {
+		t.Errorf("2^%d is not the exact power of 2 dividing P-1", MaxTwoAdicity)
+	}
+}
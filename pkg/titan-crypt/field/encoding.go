@@ -0,0 +1,104 @@
+package field
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ElementFromString parses a field element from its canonical value written in
+// decimal, or in hexadecimal with a "0x"/"0X" prefix. Leading and trailing
+// whitespace is ignored.
+//
+// Values are validated rather than reduced: anything >= P is rejected, as are
+// negative numbers (there is no sign handling; use NewFromInt64 for signed input).
+// ElementFromString(e.String()) == e for every element e.
+func ElementFromString(s string) (Element, error) {
+	trimmed := strings.TrimSpace(s)
+
+	var value uint64
+	var err error
+	if strings.HasPrefix(trimmed, "0x") || strings.HasPrefix(trimmed, "0X") {
+		value, err = strconv.ParseUint(trimmed[2:], 16, 64)
+	} else {
+		value, err = strconv.ParseUint(trimmed, 10, 64)
+	}
+	if err != nil {
+		return Zero, fmt.Errorf("invalid field element %q: %w", s, err)
+	}
+
+	if !IsCanonical(value) {
+		return Zero, fmt.Errorf("field element %q out of range: must be less than %d", s, P)
+	}
+
+	return New(value), nil
+}
+
+// Parse is ElementFromString: it accepts a canonical value in decimal or "0x"-prefixed
+// hexadecimal, as found in config files and command-line flags.
+func Parse(s string) (Element, error) {
+	return ElementFromString(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, writing the canonical value in decimal.
+func (e Element) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, e.Value(), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the forms of Parse.
+// On error e is left unchanged.
+func (e *Element) UnmarshalText(text []byte) error {
+	parsed, err := ElementFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*e = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The canonical value is written as a
+// decimal JSON string, e.g. "18446744069414584320", the representation expected
+// from twenty-first's serde output; unlike a JSON number it survives parsers that
+// read numbers as float64.
+func (e Element) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(e.Value(), 10))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the decimal string written
+// by MarshalJSON (or any form accepted by Parse) and, for interoperability with
+// encoders that emit u64 values directly, a bare JSON number. Values must be
+// canonical. A JSON null leaves e unchanged.
+func (e *Element) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var text string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+	} else {
+		text = string(data)
+	}
+	return e.UnmarshalText([]byte(text))
+}
+
+func init() {
+	// Lets Elements travel in interface-typed gob values
+	gob.Register(Element{})
+}
+
+// GobEncode implements gob.GobEncoder with the 8-byte canonical form of Bytes.
+func (e Element) GobEncode() ([]byte, error) {
+	return e.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. Like SetBytes, it rejects non-canonical values.
+func (e *Element) GobDecode(data []byte) error {
+	return e.SetBytes(data)
+}
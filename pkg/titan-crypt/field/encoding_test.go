@@ -0,0 +1,197 @@
+package field
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"flag"
+	"testing"
+)
+
+func TestElementFromString(t *testing.T) {
+	tests := []struct {
+		input string
+		want  uint64
+	}{
+		{"0", 0},
+		{"1", 1},
+		{"42", 42},
+		{"  42\n", 42},
+		{"18446744069414584320", P - 1},
+		{"0x0", 0},
+		{"0x2a", 42},
+		{"0X2A", 42},
+		{"0xFFFFFFFF00000000", P - 1},
+		{"007", 7},
+	}
+
+	for _, tt := range tests {
+		got, err := ElementFromString(tt.input)
+		if err != nil {
+			t.Errorf("ElementFromString(%q) failed: %v", tt.input, err)
+			continue
+		}
+		if got.Value() != tt.want {
+			t.Errorf("ElementFromString(%q) = %d, want %d", tt.input, got.Value(), tt.want)
+		}
+	}
+}
+
+func TestElementFromStringErrors(t *testing.T) {
+	inputs := []string{
+		"",
+		"   ",
+		"-1",
+		"+1",
+		"abc",
+		"12a",
+		"1 2",
+		"1_000",
+		"0x",
+		"0xg",
+		"0b101",
+		"18446744069414584321",       // P
+		"18446744073709551615",       // 2^64 - 1
+		"99999999999999999999999999", // overflows uint64
+		"0xFFFFFFFF00000001",         // P in hex
+	}
+
+	for _, input := range inputs {
+		if got, err := ElementFromString(input); err == nil {
+			t.Errorf("ElementFromString(%q) = %v, expected error", input, got)
+		}
+	}
+}
+
+func TestElementFromStringRoundTrip(t *testing.T) {
+	for _, e := range []Element{Zero, One, Max, New(123456789), New(P - 2), New(1 << 32)} {
+		parsed, err := ElementFromString(e.String())
+		if err != nil {
+			t.Fatalf("ElementFromString(%q) failed: %v", e.String(), err)
+		}
+		if !parsed.Equal(e) {
+			t.Errorf("round trip of %v gave %v", e, parsed)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	for input, want := range map[string]uint64{"0": 0, "42": 42, "0x2a": 42, " 0XFF ": 255, "18446744069414584320": P - 1} {
+		got, err := Parse(input)
+		if err != nil || got.Value() != want {
+			t.Errorf("Parse(%q) = %v, %v; want %d", input, got, err, want)
+		}
+	}
+	for _, input := range []string{"", "-1", "0x", "18446744069414584321", "abc"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) should fail", input)
+		}
+	}
+}
+
+func TestElementTextMarshaler(t *testing.T) {
+	var _ encoding.TextMarshaler = Element{}
+	var _ encoding.TextUnmarshaler = (*Element)(nil)
+
+	for _, e := range []Element{Zero, One, Max, New(0xdeadbeef)} {
+		text, err := e.MarshalText()
+		if err != nil || string(text) != e.String() {
+			t.Errorf("MarshalText(%v) = %q, %v", e, text, err)
+		}
+		var restored Element
+		if err := restored.UnmarshalText(text); err != nil || !restored.Equal(e) {
+			t.Errorf("UnmarshalText(%q) = %v, %v", text, restored, err)
+		}
+	}
+
+	e := New(7)
+	if err := e.UnmarshalText([]byte("not a number")); err == nil || !e.Equal(New(7)) {
+		t.Errorf("failed UnmarshalText should leave the element unchanged, got %v, %v", e, err)
+	}
+
+	// Element works directly as a command-line flag value.
+	var fromFlag Element
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.TextVar(&fromFlag, "elem", Zero, "field element")
+	if err := fs.Parse([]string{"-elem", "0x10"}); err != nil || fromFlag.Value() != 16 {
+		t.Errorf("flag parsing gave %v, %v; want 16", fromFlag, err)
+	}
+}
+
+func TestElementJSON(t *testing.T) {
+	for _, e := range []Element{Zero, One, Max, New(0x123456789)} {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatalf("Marshal(%v) failed: %v", e, err)
+		}
+		if want := `"` + e.String() + `"`; string(data) != want {
+			t.Errorf("Marshal(%v) = %s, want %s", e, data, want)
+		}
+
+		var restored Element
+		if err := json.Unmarshal(data, &restored); err != nil || !restored.Equal(e) {
+			t.Errorf("Unmarshal(%s) = %v, %v", data, restored, err)
+		}
+	}
+
+	// Bare numbers, nested values and null
+	var payload struct {
+		A Element   `json:"a"`
+		B []Element `json:"b"`
+		C Element   `json:"c"`
+	}
+	payload.C = New(9)
+	input := `{"a": 18446744069414584320, "b": ["1", 2, "0x3"], "c": null}`
+	if err := json.Unmarshal([]byte(input), &payload); err != nil {
+		t.Fatalf("Unmarshal(%s) failed: %v", input, err)
+	}
+	if payload.A.Value() != P-1 || len(payload.B) != 3 || payload.B[1].Value() != 2 || payload.B[2].Value() != 3 || payload.C.Value() != 9 {
+		t.Errorf("Unmarshal(%s) = %+v", input, payload)
+	}
+
+	for _, input := range []string{`"18446744069414584321"`, `18446744069414584321`, `-1`, `1.5`, `"x"`, `true`, `[1]`} {
+		var e Element
+		if err := json.Unmarshal([]byte(input), &e); err == nil {
+			t.Errorf("Unmarshal(%s) should fail", input)
+		}
+	}
+}
+
+func TestElementGob(t *testing.T) {
+	type record struct {
+		Value    Element
+		Values   []Element
+		Interior any
+	}
+	in := record{Value: Max, Values: []Element{Zero, One, New(1 << 40)}, Interior: New(7)}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	var out record
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+
+	if !out.Value.Equal(in.Value) || len(out.Values) != len(in.Values) {
+		t.Fatalf("round trip = %+v, want %+v", out, in)
+	}
+	for i := range in.Values {
+		if !out.Values[i].Equal(in.Values[i]) {
+			t.Errorf("Values[%d] = %v, want %v", i, out.Values[i], in.Values[i])
+		}
+	}
+	if got, ok := out.Interior.(Element); !ok || !got.Equal(New(7)) {
+		t.Errorf("Interior = %v, want 7", out.Interior)
+	}
+
+	var e Element
+	if err := e.GobDecode([]byte{1, 2, 3}); err == nil {
+		t.Error("expected error for short data")
+	}
+	if err := e.GobDecode([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}); err == nil {
+		t.Error("expected error for non-canonical value")
+	}
+}
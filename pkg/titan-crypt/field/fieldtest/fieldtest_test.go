@@ -0,0 +1,82 @@
+package fieldtest
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestBoundaryValuesAreCanonical(t *testing.T) {
+	for _, v := range boundaryValues {
+		if !field.IsCanonical(v) {
+			t.Errorf("boundary value %d is not canonical", v)
+		}
+	}
+	if len(Boundary()) != len(boundaryValues) {
+		t.Error("Boundary() length mismatch")
+	}
+}
+
+func TestArbitraryMixesBoundaryAndRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(525))
+	boundary := make(map[field.Element]bool)
+	for _, e := range Boundary() {
+		boundary[e] = true
+	}
+
+	hits := 0
+	const n = 4000
+	for _, e := range Elements(r, n) {
+		if boundary[e] {
+			hits++
+		}
+	}
+	// Expect about n/4 boundary draws
+	if hits < n/8 || hits > n/2 {
+		t.Errorf("%d of %d draws were boundary values, want about %d", hits, n, n/4)
+	}
+}
+
+func TestShrinkReducesMagnitude(t *testing.T) {
+	r := rand.New(rand.NewSource(525))
+	for _, e := range append(Boundary(), Elements(r, 200)...) {
+		for _, c := range Shrink(e) {
+			if magnitude(c) >= magnitude(e) {
+				t.Fatalf("Shrink(%v) produced %v with magnitude %d >= %d", e, c, magnitude(c), magnitude(e))
+			}
+		}
+	}
+	if len(Shrink(field.Zero)) != 0 {
+		t.Error("Zero should not shrink")
+	}
+
+	// Following the halving candidate reaches Zero in about log2 steps.
+	e := field.New(1 << 40).Neg()
+	for steps := 0; ; steps++ {
+		candidates := Shrink(e)
+		if len(candidates) == 0 {
+			break
+		}
+		if steps > 64 {
+			t.Fatal("shrinking did not terminate")
+		}
+		next := candidates[len(candidates)-1]
+		for _, c := range candidates {
+			if magnitude(c) == magnitude(e)/2 {
+				next = c
+			}
+		}
+		e = next
+	}
+}
+
+func TestQuickGenerator(t *testing.T) {
+	commutative := func(a, b Quick) bool {
+		return a.Mul(b.Element).Equal(b.Mul(a.Element))
+	}
+	if err := quick.Check(commutative, &quick.Config{Rand: rand.New(rand.NewSource(525))}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,47 @@
+package field
+
+// fixedBaseWindow is the window width in bits of FixedBaseExp's tables.
+const fixedBaseWindow = 8
+
+// FixedBaseExp answers repeated exponentiations of one base. It stores
+// table[j][d] = base^(d · 2^(8j)) for every 8-bit digit d and each of the 8 byte
+// positions j of a uint64 exponent (2048 elements, 16 KiB), so Exp costs 7
+// multiplications instead of ModPow's 64 squarings plus one multiplication per
+// set bit. Building the table costs about 2048 multiplications, which pays off
+// after a few dozen calls.
+//
+// A FixedBaseExp is immutable and safe for concurrent use.
+type FixedBaseExp struct {
+	base  Element
+	table [64 / fixedBaseWindow][1 << fixedBaseWindow]Element
+}
+
+// NewFixedBaseExp precomputes the window tables for base.
+func NewFixedBaseExp(base Element) *FixedBaseExp {
+	f := &FixedBaseExp{base: base}
+	windowBase := base // base^(2^(8j))
+	for j := range f.table {
+		row := &f.table[j]
+		row[0] = One
+		for d := 1; d < len(row); d++ {
+			row[d] = row[d-1].Mul(windowBase)
+		}
+		windowBase = row[len(row)-1].Mul(windowBase)
+	}
+	return f
+}
+
+// Base returns the base the tables were built for.
+func (f *FixedBaseExp) Base() Element {
+	return f.base
+}
+
+// Exp returns base^exp, equal to f.Base().ModPow(exp).
+func (f *FixedBaseExp) Exp(exp uint64) Element {
+	acc := f.table[0][exp&(1<<fixedBaseWindow-1)]
+	for j := 1; j < len(f.table); j++ {
+		exp >>= fixedBaseWindow
+		acc = acc.Mul(f.table[j][exp&(1<<fixedBaseWindow-1)])
+	}
+	return acc
+}
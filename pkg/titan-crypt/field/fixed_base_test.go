@@ -0,0 +1,49 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestFixedBaseExpMatchesModPow(t *testing.T) {
+	rng := rand.New(rand.NewSource(514))
+	for _, base := range []Element{Zero, One, Generator(), PrimitiveRootOfUnity(1 << 16), New(rng.Uint64())} {
+		f := NewFixedBaseExp(base)
+		if !f.Base().Equal(base) {
+			t.Fatalf("Base() = %v, want %v", f.Base(), base)
+		}
+
+		exps := []uint64{0, 1, 2, 255, 256, 1 << 32, P - 1, P - 2, ^uint64(0)}
+		for i := 0; i < 100; i++ {
+			exps = append(exps, rng.Uint64())
+		}
+		for _, exp := range exps {
+			if got, want := f.Exp(exp), base.ModPow(exp); !got.Equal(want) {
+				t.Fatalf("%v^%d = %v, want %v", base, exp, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkFixedBaseExp(b *testing.B) {
+	f := NewFixedBaseExp(Generator())
+	exp := uint64(0xdeadbeefcafebabe)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f.Exp(exp + uint64(i))
+	}
+}
+
+func BenchmarkFixedBaseModPow(b *testing.B) {
+	g := Generator()
+	exp := uint64(0xdeadbeefcafebabe)
+	for i := 0; i < b.N; i++ {
+		g.ModPow(exp + uint64(i))
+	}
+}
+
+func BenchmarkNewFixedBaseExp(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NewFixedBaseExp(Generator())
+	}
+}
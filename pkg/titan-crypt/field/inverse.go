@@ -0,0 +1,76 @@
+package field
+
+// halfP1 is (P+1)/2, the inverse of 2 modulo P.
+const halfP1 uint64 = (P + 1) / 2
+
+// inverseBinaryGCD computes the inverse of a nonzero element with the binary
+// extended Euclidean algorithm on canonical values. Its running time depends
+// on the input.
+func inverseBinaryGCD(e Element) Element {
+	u, v := e.Value(), P
+	x1, x2 := uint64(1), uint64(0)
+
+	// Invariants: x1·a ≡ u and x2·a ≡ v (mod P)
+	for u != 1 && v != 1 {
+		for u&1 == 0 {
+			u >>= 1
+			x1 = halveModP(x1)
+		}
+		for v&1 == 0 {
+			v >>= 1
+			x2 = halveModP(x2)
+		}
+
+		if u >= v {
+			u -= v
+			x1 = subModP(x1, x2)
+		} else {
+			v -= u
+			x2 = subModP(x2, x1)
+		}
+	}
+
+	if u == 1 {
+		return New(x1)
+	}
+	return New(x2)
+}
+
+// halveModP returns x/2 mod P for canonical x.
+func halveModP(x uint64) uint64 {
+	// For odd x, (x + P)/2 = (x - 1)/2 + (P + 1)/2 avoids overflowing x + P
+	return (x >> 1) + (x&1)*halfP1
+}
+
+// subModP returns x - y mod P for canonical x and y.
+func subModP(x, y uint64) uint64 {
+	if x >= y {
+		return x - y
+	}
+	return x + (P - y)
+}
+
+// InverseViaGCD computes the multiplicative inverse with the binary extended
+// Euclidean algorithm. It returns the same result as Inverse but its running
+// time depends on the input. Its data-dependent branches mispredict often enough
+// that it is slower than the addition chain (compare BenchmarkInverseViaGCD
+// with BenchmarkInverseConstantTime), so Inverse keeps using the chain.
+//
+// Panics if e is zero.
+func InverseViaGCD(e Element) Element {
+	if e.IsZero() {
+		panic("attempted to find the multiplicative inverse of zero")
+	}
+	return inverseBinaryGCD(e)
+}
+
+// InverseConstantTime computes the multiplicative inverse as e^(P-2) with a
+// fixed addition chain, so the sequence of operations does not depend on e.
+//
+// Panics if e is zero.
+func InverseConstantTime(e Element) Element {
+	if e.IsZero() {
+		panic("attempted to find the multiplicative inverse of zero")
+	}
+	return powInvChain(e)
+}
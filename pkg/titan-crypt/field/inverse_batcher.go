@@ -0,0 +1,59 @@
+package field
+
+// InverseBatcher collects elements whose inverses are needed later and computes
+// them all at once with a single Montgomery batch inversion (one field inversion
+// plus three multiplications per element).
+//
+// The zero value is ready to use. An InverseBatcher is not safe for concurrent use.
+type InverseBatcher struct {
+	elements []Element
+	promises []*InversePromise
+}
+
+// InversePromise holds the inverse of an element added to an InverseBatcher.
+// Its value becomes available once the batcher is resolved.
+type InversePromise struct {
+	value    Element
+	resolved bool
+}
+
+// Add queues e for inversion and returns a promise for its inverse.
+func (b *InverseBatcher) Add(e Element) *InversePromise {
+	promise := &InversePromise{}
+	b.elements = append(b.elements, e)
+	b.promises = append(b.promises, promise)
+	return promise
+}
+
+// Len returns the number of elements waiting to be resolved.
+func (b *InverseBatcher) Len() int {
+	return len(b.elements)
+}
+
+// Resolve inverts every queued element and fulfills the corresponding promises.
+// Zero has no inverse; its promise resolves to Zero. Afterwards the batcher is
+// empty and can be reused for a new batch.
+func (b *InverseBatcher) Resolve() {
+	inverses := BatchInverse(b.elements)
+	for i, promise := range b.promises {
+		promise.value = inverses[i]
+		promise.resolved = true
+	}
+
+	b.elements = nil
+	b.promises = nil
+}
+
+// Resolved reports whether the promise's value is available.
+func (p *InversePromise) Resolved() bool {
+	return p.resolved
+}
+
+// Get returns the inverse.
+// Panics if the batcher that issued the promise has not been resolved yet.
+func (p *InversePromise) Get() Element {
+	if !p.resolved {
+		panic("inverse promise read before InverseBatcher.Resolve")
+	}
+	return p.value
+}
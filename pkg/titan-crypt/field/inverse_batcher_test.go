@@ -0,0 +1,79 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestInverseBatcher(t *testing.T) {
+	rng := rand.New(rand.NewSource(411))
+
+	var batcher InverseBatcher
+	elements := []Element{One, Max, New(2), Generator()}
+	for i := 0; i < 100; i++ {
+		elements = append(elements, New(rng.Uint64()))
+	}
+
+	promises := make([]*InversePromise, len(elements))
+	for i, e := range elements {
+		promises[i] = batcher.Add(e)
+	}
+	if batcher.Len() != len(elements) {
+		t.Fatalf("Len() = %d, want %d", batcher.Len(), len(elements))
+	}
+	for _, p := range promises {
+		if p.Resolved() {
+			t.Fatal("promise resolved before Resolve")
+		}
+	}
+
+	batcher.Resolve()
+
+	for i, e := range elements {
+		if !promises[i].Resolved() {
+			t.Fatalf("promise %d not resolved", i)
+		}
+		if got, want := promises[i].Get(), e.Inverse(); !got.Equal(want) {
+			t.Errorf("promise %d = %v, want %v", i, got, want)
+		}
+	}
+	if batcher.Len() != 0 {
+		t.Errorf("Len() after Resolve = %d, want 0", batcher.Len())
+	}
+}
+
+func TestInverseBatcherZeroAndReuse(t *testing.T) {
+	var batcher InverseBatcher
+	zero := batcher.Add(Zero)
+	two := batcher.Add(New(2))
+	batcher.Resolve()
+
+	if !zero.Get().IsZero() {
+		t.Errorf("inverse of zero resolved to %v, want 0", zero.Get())
+	}
+	if !two.Get().Equal(New(2).Inverse()) {
+		t.Errorf("inverse of 2 = %v", two.Get())
+	}
+
+	// A second batch must not disturb promises from the first
+	three := batcher.Add(New(3))
+	batcher.Resolve()
+	if !three.Get().Equal(New(3).Inverse()) || !two.Get().Equal(New(2).Inverse()) {
+		t.Error("reusing the batcher corrupted promises")
+	}
+
+	// Resolving an empty batcher is a no-op
+	batcher.Resolve()
+}
+
+func TestInversePromiseGetBeforeResolve(t *testing.T) {
+	var batcher InverseBatcher
+	promise := batcher.Add(New(5))
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Get before Resolve did not panic")
+		}
+	}()
+	promise.Get()
+}
@@ -0,0 +1,72 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestInverseVariantsAgree(t *testing.T) {
+	rng := rand.New(rand.NewSource(406))
+
+	values := []Element{One, Max, New(2), New(P / 2), New(halfP1), New(1 << 32), New(epsilon), Generator()}
+	for i := 0; i < 10000; i++ {
+		if v := New(rng.Uint64()); !v.IsZero() {
+			values = append(values, v)
+		}
+	}
+
+	for _, e := range values {
+		gcd := InverseViaGCD(e)
+		fermat := InverseConstantTime(e)
+		if !gcd.Equal(fermat) {
+			t.Fatalf("InverseViaGCD(%v) = %v, InverseConstantTime = %v", e, gcd, fermat)
+		}
+		if !e.Mul(gcd).IsOne() {
+			t.Fatalf("%v · InverseViaGCD = %v, want 1", e, e.Mul(gcd))
+		}
+		if !e.Inverse().Equal(fermat) {
+			t.Fatalf("Inverse(%v) differs from InverseConstantTime", e)
+		}
+	}
+}
+
+func TestInverseVariantsPanicOnZero(t *testing.T) {
+	for name, f := range map[string]func(Element) Element{
+		"InverseViaGCD":       InverseViaGCD,
+		"InverseConstantTime": InverseConstantTime,
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s(0) did not panic", name)
+				}
+			}()
+			f(Zero)
+		}()
+	}
+}
+
+func benchmarkInverseInputs() []Element {
+	rng := rand.New(rand.NewSource(406))
+	values := make([]Element, 1024)
+	for i := range values {
+		values[i] = New(rng.Uint64() | 1)
+	}
+	return values
+}
+
+func BenchmarkInverseViaGCD(b *testing.B) {
+	values := benchmarkInverseInputs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		InverseViaGCD(values[i%len(values)])
+	}
+}
+
+func BenchmarkInverseConstantTime(b *testing.B) {
+	values := benchmarkInverseInputs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		InverseConstantTime(values[i%len(values)])
+	}
+}
@@ -0,0 +1,79 @@
+package field
+
+import (
+	"math/bits"
+)
+
+// epsilon is 2^64 mod P = 2^32 - 1.
+// Any carry out of (or borrow into) bit 64 can be folded back by adding (or subtracting) it.
+const epsilon uint64 = 0xFFFFFFFF
+
+// LazyElement is a field element in Montgomery form whose representative is
+// not necessarily canonical: it may be any value in [0, 2^64) congruent to the
+// element modulo P. Since 2^64 < 2P, at most one subtraction of P is needed to
+// canonicalize it.
+//
+// Add and Sub skip the final conditional reduction that Element performs, which
+// makes LazyElement suitable for inner loops with many additions between
+// multiplications (e.g. NTT butterflies). Call Freeze to obtain a canonical Element.
+//
+// This type is experimental.
+type LazyElement struct {
+	// value in Montgomery form, congruent modulo P but possibly >= P
+	value uint64
+}
+
+// Lazy converts a canonical element into its lazy representation.
+func (e Element) Lazy() LazyElement {
+	return LazyElement{value: e.value}
+}
+
+// Add performs field addition without canonicalizing the result.
+func (a LazyElement) Add(b LazyElement) LazyElement {
+	sum, carry := bits.Add64(a.value, b.value, 0)
+
+	// Fold the carry: 2^64 ≡ epsilon (mod P). A second carry leaves a value
+	// below epsilon, so folding it once more cannot overflow.
+	sum, carry = bits.Add64(sum, carry*epsilon, 0)
+	return LazyElement{value: sum + carry*epsilon}
+}
+
+// Sub performs field subtraction without canonicalizing the result.
+func (a LazyElement) Sub(b LazyElement) LazyElement {
+	diff, borrow := bits.Sub64(a.value, b.value, 0)
+
+	// Fold the borrow: -2^64 ≡ -epsilon (mod P). A second borrow leaves a value
+	// of at least 2^64 - epsilon, so folding it once more cannot underflow.
+	diff, borrow = bits.Sub64(diff, borrow*epsilon, 0)
+	return LazyElement{value: diff - borrow*epsilon}
+}
+
+// AddElement adds a canonical element without canonicalizing the result.
+// Because b < P = 2^64 - epsilon, a single carry fold cannot overflow, making
+// this cheaper than Add.
+func (a LazyElement) AddElement(b Element) LazyElement {
+	sum, carry := bits.Add64(a.value, b.value, 0)
+	return LazyElement{value: sum + carry*epsilon}
+}
+
+// SubElement subtracts a canonical element without canonicalizing the result.
+// Because b < P, a single borrow fold cannot underflow, making this cheaper than Sub.
+func (a LazyElement) SubElement(b Element) LazyElement {
+	diff, borrow := bits.Sub64(a.value, b.value, 0)
+	return LazyElement{value: diff - borrow*epsilon}
+}
+
+// Mul multiplies by a canonical element.
+// The 128-bit product stays below P * 2^64, which is all montyred requires,
+// so the result is already canonical and is returned as an Element.
+func (a LazyElement) Mul(b Element) Element {
+	return Element{value: montyred(mul128(a.value, b.value))}
+}
+
+// Freeze returns the canonical element represented by a.
+func (a LazyElement) Freeze() Element {
+	if a.value >= P {
+		return Element{value: a.value - P}
+	}
+	return Element{value: a.value}
+}
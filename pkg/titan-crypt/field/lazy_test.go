@@ -0,0 +1,95 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// lazyBoundaryValues are non-canonical raw representatives that exercise the carry and borrow folds.
+var lazyBoundaryValues = []uint64{0, 1, epsilon - 1, epsilon, epsilon + 1, P - 1, P, P + 1, ^uint64(0) - epsilon, ^uint64(0) - 1, ^uint64(0)}
+
+func TestLazyElementMatchesStrict(t *testing.T) {
+	for _, a := range lazyBoundaryValues {
+		for _, b := range lazyBoundaryValues {
+			la, lb := LazyElement{value: a}, LazyElement{value: b}
+			ea, eb := la.Freeze(), lb.Freeze()
+
+			if got, want := la.Add(lb).Freeze(), ea.Add(eb); !got.Equal(want) {
+				t.Errorf("lazy %d + %d = %d, want %d", a, b, got.value, want.value)
+			}
+			if got, want := la.Sub(lb).Freeze(), ea.Sub(eb); !got.Equal(want) {
+				t.Errorf("lazy %d - %d = %d, want %d", a, b, got.value, want.value)
+			}
+			if got, want := la.Mul(eb), ea.Mul(eb); !got.Equal(want) {
+				t.Errorf("lazy %d * %d = %d, want %d", a, b, got.value, want.value)
+			}
+			if got, want := la.AddElement(eb).Freeze(), ea.Add(eb); !got.Equal(want) {
+				t.Errorf("lazy %d + canonical %d = %d, want %d", a, b, got.value, want.value)
+			}
+			if got, want := la.SubElement(eb).Freeze(), ea.Sub(eb); !got.Equal(want) {
+				t.Errorf("lazy %d * %d = %d, want %d", a, b, got.value, want.value)
+			}
+		}
+	}
+}
+
+func TestLazyElementChains(t *testing.T) {
+	// Long chains of lazy operations must agree with the fully reduced computation
+	rng := rand.New(rand.NewSource(383))
+
+	lazy := Zero.Lazy()
+	strict := Zero
+	for i := 0; i < 10000; i++ {
+		e := New(rng.Uint64())
+		switch rng.Intn(5) {
+		case 0:
+			lazy = lazy.Add(e.Lazy())
+			strict = strict.Add(e)
+		case 1:
+			lazy = lazy.Sub(e.Lazy())
+			strict = strict.Sub(e)
+		case 2:
+			lazy = lazy.AddElement(e)
+			strict = strict.Add(e)
+		case 3:
+			lazy = lazy.SubElement(e)
+			strict = strict.Sub(e)
+		default:
+			lazy = lazy.Mul(e).Lazy()
+			strict = strict.Mul(e)
+		}
+
+		if !lazy.Freeze().Equal(strict) {
+			t.Fatalf("step %d: lazy result %v differs from strict %v", i, lazy.Freeze(), strict)
+		}
+	}
+}
+
+func TestLazyElementFreezeCanonical(t *testing.T) {
+	for _, v := range lazyBoundaryValues {
+		frozen := LazyElement{value: v}.Freeze()
+		if frozen.value >= P {
+			t.Errorf("Freeze(%d) = %d is not canonical", v, frozen.value)
+		}
+	}
+}
+
+func BenchmarkButterflyStrict(b *testing.B) {
+	u, v, w := New(123456789), New(987654321), New(555555555)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		t := v.Mul(w)
+		u, v = u.Add(t), u.Sub(t)
+	}
+	_, _ = u, v
+}
+
+func BenchmarkButterflyLazy(b *testing.B) {
+	u, v, w := New(123456789).Lazy(), New(987654321).Lazy(), New(555555555)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		t := v.Mul(w)
+		u, v = u.AddElement(t), u.SubElement(t)
+	}
+	_, _ = u.Freeze(), v.Freeze()
+}
@@ -0,0 +1,77 @@
+package field
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// NthRoot returns an n-th root of e and true, or (Zero, false) if e is not an
+// n-th power. Zero is its own n-th root. Every n dividing P-1 is supported; cube
+// roots (n = 3) invert the x³ maps of arithmetization-oriented permutations.
+//
+// Write n = 2^j·o with o odd. Each odd prime divides P-1 exactly once, so o is
+// coprime to (P-1)/o and y = e^(o⁻¹ mod (P-1)/o) is an o-th root of e. The 2^j-th
+// root of y is then taken with j square roots: since -1 is a 2^31-th power, either
+// square root of a 2^k-th power is a 2^(k-1)-th power, so no backtracking is needed.
+//
+// Panics if n is zero or does not divide P-1.
+func (e Element) NthRoot(n uint64) (Element, bool) {
+	checkRootDegree(n)
+	if e.IsZero() {
+		return Zero, true
+	}
+	if !e.ModPow((P - 1) / n).IsOne() {
+		return Zero, false
+	}
+
+	j := bits.TrailingZeros64(n)
+	odd := n >> j
+
+	root := e
+	if odd > 1 {
+		m := (P - 1) / odd
+		d := new(big.Int).ModInverse(new(big.Int).SetUint64(odd), new(big.Int).SetUint64(m))
+		root = root.ModPow(d.Uint64())
+	}
+	for i := 0; i < j; i++ {
+		var ok bool
+		if root, ok = root.Sqrt(); !ok {
+			// Unreachable: e was verified to be an n-th power
+			return Zero, false
+		}
+	}
+	return root, true
+}
+
+// NthRoots returns all n-th roots of e: empty if e is not an n-th power, [Zero]
+// for zero, and otherwise the n values r·ζⁱ for a root r and the primitive n-th
+// root of unity ζ. The result has n entries, so this is meant for small n.
+//
+// Panics if n is zero or does not divide P-1.
+func (e Element) NthRoots(n uint64) []Element {
+	root, ok := e.NthRoot(n)
+	if !ok {
+		return []Element{}
+	}
+	if root.IsZero() {
+		return []Element{Zero}
+	}
+
+	zeta, err := GeneratePrimitiveRoot(n)
+	if err != nil {
+		panic(err)
+	}
+	roots := make([]Element, n)
+	for i := range roots {
+		roots[i] = root
+		root = root.Mul(zeta)
+	}
+	return roots
+}
+
+func checkRootDegree(n uint64) {
+	if n == 0 || (P-1)%n != 0 {
+		panic(fmt.Sprintf("root degree %d does not divide P-1", n))
+	}
+}
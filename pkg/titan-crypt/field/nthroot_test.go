@@ -0,0 +1,82 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestNthRoot(t *testing.T) {
+	rng := rand.New(rand.NewSource(523))
+	for _, n := range []uint64{1, 2, 3, 5, 6, 8, 15, 17 * 4, 257, 1 << 10, 3 << 20, 65537} {
+		for i := 0; i < 20; i++ {
+			x := New(rng.Uint64())
+			e := x.ModPow(n)
+			root, ok := e.NthRoot(n)
+			if !ok {
+				t.Fatalf("n=%d: %v^n reported as not an n-th power", n, x)
+			}
+			if !root.ModPow(n).Equal(e) {
+				t.Fatalf("n=%d: NthRoot(%v)^n = %v", n, e, root.ModPow(n))
+			}
+		}
+	}
+
+	if root, ok := Zero.NthRoot(3); !ok || !root.IsZero() {
+		t.Error("the cube root of zero should be zero")
+	}
+}
+
+func TestNthRootNonResidues(t *testing.T) {
+	// The generator is not an n-th power for any n > 1.
+	for _, n := range []uint64{2, 3, 5, 1 << 32} {
+		if _, ok := Generator().NthRoot(n); ok {
+			t.Errorf("generator reported as an %d-th power", n)
+		}
+		if len(Generator().NthRoots(n)) != 0 {
+			t.Errorf("NthRoots(%d) of the generator should be empty", n)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(523))
+	for i := 0; i < 100; i++ {
+		e := New(rng.Uint64())
+		_, ok := e.NthRoot(3)
+		if want := PowThirdOrder(e).IsOne(); ok != want {
+			t.Fatalf("NthRoot(%v, 3) ok = %v, cubic residuosity says %v", e, ok, want)
+		}
+	}
+}
+
+func TestNthRoots(t *testing.T) {
+	e := New(12345).ModPow(15)
+	roots := e.NthRoots(15)
+	if len(roots) != 15 {
+		t.Fatalf("got %d roots, want 15", len(roots))
+	}
+	seen := make(map[Element]bool)
+	for _, r := range roots {
+		if !r.ModPow(15).Equal(e) {
+			t.Errorf("%v is not a 15th root", r)
+		}
+		seen[r] = true
+	}
+	if len(seen) != 15 {
+		t.Errorf("roots are not distinct: %d unique", len(seen))
+	}
+	if roots := Zero.NthRoots(4); len(roots) != 1 || !roots[0].IsZero() {
+		t.Errorf("NthRoots of zero = %v", roots)
+	}
+}
+
+func TestNthRootPanicsOnInvalidDegree(t *testing.T) {
+	for _, n := range []uint64{0, 7, 9} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NthRoot(%d) should panic", n)
+				}
+			}()
+			One.NthRoot(n)
+		}()
+	}
+}
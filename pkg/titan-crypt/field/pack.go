@@ -0,0 +1,82 @@
+package field
+
+import (
+	"fmt"
+)
+
+// maxPackedLen is the longest encoding of a single element produced by PackElements.
+const maxPackedLen = 9
+
+// PackElements encodes the canonical values of elements with a variable-length
+// code that uses fewer bytes for small values.
+//
+// Each value is written as base-128 digits, least significant first, with the
+// high bit of every byte set when another byte follows (as in LEB128). The first
+// eight bytes carry 7 bits each; if a ninth byte is needed it carries the top 8 bits
+// with no continuation flag. Values below 2^7 take one byte, values below 2^56 at
+// most eight, and every element at most nine.
+func PackElements(elements []Element) []byte {
+	out := make([]byte, 0, len(elements))
+	for _, e := range elements {
+		out = appendPacked(out, e.Value())
+	}
+	return out
+}
+
+func appendPacked(out []byte, v uint64) []byte {
+	for i := 0; i < maxPackedLen-1; i++ {
+		if v < 0x80 {
+			return append(out, byte(v))
+		}
+		out = append(out, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(out, byte(v))
+}
+
+// UnpackElements decodes data written by PackElements.
+// Returns an error for truncated input, non-minimal encodings, and values >= P,
+// so every accepted input has exactly one encoding.
+func UnpackElements(data []byte) ([]Element, error) {
+	var elements []Element
+	for pos := 0; pos < len(data); {
+		v, n, err := readPacked(data[pos:])
+		if err != nil {
+			return nil, fmt.Errorf("element %d at byte %d: %w", len(elements), pos, err)
+		}
+		if !IsCanonical(v) {
+			return nil, fmt.Errorf("element %d at byte %d: value %d is not canonical", len(elements), pos, v)
+		}
+		elements = append(elements, New(v))
+		pos += n
+	}
+	return elements, nil
+}
+
+// readPacked decodes one value and returns it with the number of bytes consumed.
+func readPacked(data []byte) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < maxPackedLen; i++ {
+		if i >= len(data) {
+			return 0, 0, fmt.Errorf("truncated encoding")
+		}
+		b := data[i]
+
+		if i == maxPackedLen-1 {
+			// The ninth byte holds the top 8 bits
+			if b == 0 {
+				return 0, 0, fmt.Errorf("non-minimal encoding")
+			}
+			return v | uint64(b)<<56, i + 1, nil
+		}
+
+		v |= uint64(b&0x7F) << (7 * i)
+		if b&0x80 == 0 {
+			if b == 0 && i > 0 {
+				return 0, 0, fmt.Errorf("non-minimal encoding")
+			}
+			return v, i + 1, nil
+		}
+	}
+	panic("unreachable")
+}
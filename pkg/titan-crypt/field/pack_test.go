@@ -0,0 +1,109 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPackElementsRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(408))
+
+	elements := []Element{Zero, One, New(0x7F), New(0x80), New(0x3FFF), New(0x4000), Max, New(P - 2)}
+	// Values around every byte-length boundary, including the 2^56 ninth-byte switch
+	for shift := 1; shift < 64; shift++ {
+		boundary := uint64(1) << shift
+		for _, v := range []uint64{boundary - 1, boundary, boundary + 1} {
+			if v < P {
+				elements = append(elements, New(v))
+			}
+		}
+	}
+	// Random values across the full range and at every magnitude
+	for i := 0; i < 1000; i++ {
+		elements = append(elements, New(rng.Uint64()), New(rng.Uint64()>>rng.Intn(64)))
+	}
+
+	packed := PackElements(elements)
+	unpacked, err := UnpackElements(packed)
+	if err != nil {
+		t.Fatalf("UnpackElements failed: %v", err)
+	}
+	if len(unpacked) != len(elements) {
+		t.Fatalf("unpacked %d elements, want %d", len(unpacked), len(elements))
+	}
+	for i := range elements {
+		if !unpacked[i].Equal(elements[i]) {
+			t.Fatalf("element %d: got %v, want %v", i, unpacked[i], elements[i])
+		}
+	}
+}
+
+func TestPackElementsSizes(t *testing.T) {
+	tests := []struct {
+		value uint64
+		size  int
+	}{
+		{0, 1},
+		{0x7F, 1},
+		{0x80, 2},
+		{1<<14 - 1, 2},
+		{1 << 14, 3},
+		{1<<56 - 1, 8},
+		{1 << 56, 9},
+		{P - 1, 9},
+	}
+
+	for _, tt := range tests {
+		if got := len(PackElements([]Element{New(tt.value)})); got != tt.size {
+			t.Errorf("packed size of %d = %d, want %d", tt.value, got, tt.size)
+		}
+	}
+
+	if got := PackElements(nil); len(got) != 0 {
+		t.Errorf("PackElements(nil) = %v", got)
+	}
+}
+
+func TestUnpackElementsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"truncated", []byte{0x80}},
+		{"truncated after valid element", []byte{0x05, 0xFF, 0xFF}},
+		{"non-minimal two bytes", []byte{0x81, 0x00}},
+		{"non-minimal ninth byte", []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x00}},
+		{"value P", appendPacked(nil, P)},
+		{"value 2^64-1", []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}},
+	}
+	for _, tt := range tests {
+		if _, err := UnpackElements(tt.data); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+
+	if got, err := UnpackElements(nil); err != nil || len(got) != 0 {
+		t.Errorf("UnpackElements(nil) = %v, %v", got, err)
+	}
+}
+
+func BenchmarkPackElementsSmallValues(b *testing.B) {
+	// Dominated by small counters, with one full-range value in 16
+	rng := rand.New(rand.NewSource(408))
+	elements := make([]Element, 4096)
+	for i := range elements {
+		if i%16 == 0 {
+			elements[i] = New(rng.Uint64())
+		} else {
+			elements[i] = New(uint64(rng.Intn(1000)))
+		}
+	}
+
+	var packed []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		packed = PackElements(elements)
+	}
+	b.ReportMetric(float64(len(packed))/float64(len(elements)), "bytes/elem")
+	b.ReportMetric(float64(8*len(elements))/float64(len(packed)), "ratio-vs-fixed")
+}
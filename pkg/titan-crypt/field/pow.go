@@ -0,0 +1,101 @@
+package field
+
+import "math/big"
+
+// Fixed-exponent powers computed with precomputed addition chains. Each takes
+// far fewer multiplications than ModPow, which pays one squaring per bit plus
+// one multiplication per set bit of a runtime exponent.
+
+// squareN returns base^(2^n).
+func squareN(base Element, n int) Element {
+	for i := 0; i < n; i++ {
+		base = base.Square()
+	}
+	return base
+}
+
+// powInvChain computes x^(P-2) with the inversion chain from twenty-first.
+// P - 2 = (2^32 - 2)·2^32 + (2^32 - 1).
+func powInvChain(x Element) Element {
+	bin2Ones := x.Square().Mul(x)                      // x^3
+	bin3Ones := bin2Ones.Square().Mul(x)               // x^7
+	bin6Ones := squareN(bin3Ones, 3).Mul(bin3Ones)     // x^63
+	bin12Ones := squareN(bin6Ones, 6).Mul(bin6Ones)    // x^(2^12 - 1)
+	bin24Ones := squareN(bin12Ones, 12).Mul(bin12Ones) // x^(2^24 - 1)
+	bin30Ones := squareN(bin24Ones, 6).Mul(bin6Ones)   // x^(2^30 - 1)
+	bin31Ones := bin30Ones.Square().Mul(x)             // x^(2^31 - 1)
+	bin31Ones1Zero := bin31Ones.Square()               // x^(2^32 - 2)
+	bin32Ones := bin31Ones.Square().Mul(x)             // x^(2^32 - 1)
+
+	return squareN(bin31Ones1Zero, 32).Mul(bin32Ones)
+}
+
+// PowInv returns e^(P-2). For nonzero e this is e.Inverse(); unlike Inverse it
+// maps zero to zero instead of panicking.
+func PowInv(e Element) Element {
+	return powInvChain(e)
+}
+
+// PowHalfOrder returns e^((P-1)/2), Euler's criterion: One if e is a nonzero
+// square, -One if it is a non-square, and Zero for zero.
+// (P-1)/2 = (2^32 - 1)·2^31.
+func PowHalfOrder(e Element) Element {
+	return squareN(powOddPart(e), 31)
+}
+
+// powOddPart returns e^(2^32 - 1), the power for the odd part of P - 1 = (2^32 - 1)·2^32.
+func powOddPart(e Element) Element {
+	bin2Ones := e.Square().Mul(e)                   // e^3
+	bin4Ones := squareN(bin2Ones, 2).Mul(bin2Ones)  // e^(2^4 - 1)
+	bin8Ones := squareN(bin4Ones, 4).Mul(bin4Ones)  // e^(2^8 - 1)
+	bin16Ones := squareN(bin8Ones, 8).Mul(bin8Ones) // e^(2^16 - 1)
+
+	return squareN(bin16Ones, 16).Mul(bin16Ones) // e^(2^32 - 1)
+}
+
+// PowThirdOrder returns e^((P-1)/3), a cube root of unity that is One exactly
+// when e is a nonzero cube, and Zero for zero.
+// (P-1)/3 = 0x55555555·2^32, where 0x55555555 = Σ 4^i for i < 16.
+func PowThirdOrder(e Element) Element {
+	pattern1 := e                                    // e^0b01
+	pattern2 := squareN(pattern1, 2).Mul(pattern1)   // e^0b0101
+	pattern4 := squareN(pattern2, 4).Mul(pattern2)   // e^0x55
+	pattern8 := squareN(pattern4, 8).Mul(pattern4)   // e^0x5555
+	pattern16 := squareN(pattern8, 16).Mul(pattern8) // e^0x55555555
+
+	return squareN(pattern16, 32)
+}
+
+// bigGroupOrder is P-1, the order of the multiplicative group.
+var bigGroupOrder = new(big.Int).SetUint64(P - 1)
+
+// ModPowBig returns e^exp for an exponent of any size. For nonzero e the
+// exponent is reduced modulo P-1, the order of the multiplicative group, so the
+// cost is one big.Int division plus one ModPow. Negative exponents invert e as
+// PowSigned does; 0^0 is One.
+//
+// Panics if e is zero and exp is negative.
+func (e Element) ModPowBig(exp *big.Int) Element {
+	if exp.Sign() < 0 {
+		return e.Inverse().ModPowBig(new(big.Int).Neg(exp))
+	}
+	if e.IsZero() {
+		if exp.Sign() == 0 {
+			return One
+		}
+		return Zero
+	}
+	return e.ModPow(new(big.Int).Mod(exp, bigGroupOrder).Uint64())
+}
+
+// PowSigned returns e^exp for a signed exponent: ModPow for exp >= 0 and
+// (1/e)^|exp| for exp < 0. math.MinInt64 is handled exactly.
+//
+// Panics if e is zero and exp is negative.
+func (e Element) PowSigned(exp int64) Element {
+	if exp >= 0 {
+		return e.ModPow(uint64(exp))
+	}
+	// -exp overflows for math.MinInt64, but its uint64 conversion is still 2^63
+	return e.Inverse().ModPow(uint64(-exp))
+}
@@ -0,0 +1,177 @@
+package field
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func powTestValues(rng *rand.Rand) []Element {
+	values := []Element{Zero, One, Max, New(2), Generator()}
+	for i := 0; i < 200; i++ {
+		values = append(values, New(rng.Uint64()))
+	}
+	return values
+}
+
+func TestPowInv(t *testing.T) {
+	rng := rand.New(rand.NewSource(402))
+	for _, e := range powTestValues(rng) {
+		got := PowInv(e)
+		if want := e.ModPow(P - 2); !got.Equal(want) {
+			t.Errorf("PowInv(%v) = %v, want %v", e, got, want)
+		}
+		if !e.IsZero() && !got.Equal(e.Inverse()) {
+			t.Errorf("PowInv(%v) = %v, want Inverse %v", e, got, e.Inverse())
+		}
+	}
+}
+
+func TestPowHalfOrder(t *testing.T) {
+	rng := rand.New(rand.NewSource(402))
+	for _, e := range powTestValues(rng) {
+		got := PowHalfOrder(e)
+		if want := e.ModPow((P - 1) / 2); !got.Equal(want) {
+			t.Errorf("PowHalfOrder(%v) = %v, want %v", e, got, want)
+		}
+
+		// Legendre symbol: squares map to 1, non-squares to -1
+		switch {
+		case e.IsZero():
+			if !got.IsZero() {
+				t.Errorf("PowHalfOrder(0) = %v", got)
+			}
+		case !got.IsOne() && !got.Equal(One.Neg()):
+			t.Errorf("PowHalfOrder(%v) = %v is not ±1", e, got)
+		}
+		if sq := e.Square(); !sq.IsZero() && !PowHalfOrder(sq).IsOne() {
+			t.Errorf("PowHalfOrder(%v²) != 1", e)
+		}
+	}
+
+	// The multiplicative generator is a non-square
+	if got := PowHalfOrder(Generator()); !got.Equal(One.Neg()) {
+		t.Errorf("PowHalfOrder(generator) = %v, want -1", got)
+	}
+}
+
+func TestPowThirdOrder(t *testing.T) {
+	rng := rand.New(rand.NewSource(402))
+	for _, e := range powTestValues(rng) {
+		got := PowThirdOrder(e)
+		if want := e.ModPow((P - 1) / 3); !got.Equal(want) {
+			t.Errorf("PowThirdOrder(%v) = %v, want %v", e, got, want)
+		}
+		if !e.IsZero() && !got.ModPow(3).IsOne() {
+			t.Errorf("PowThirdOrder(%v) = %v is not a cube root of unity", e, got)
+		}
+		if cube := e.ModPow(3); !cube.IsZero() && !PowThirdOrder(cube).IsOne() {
+			t.Errorf("PowThirdOrder(%v³) != 1", e)
+		}
+	}
+
+	if PowThirdOrder(Generator()).IsOne() {
+		t.Error("the multiplicative generator must not be a cube")
+	}
+}
+
+// Compare with BenchmarkElementInverse, which runs the same chain.
+func BenchmarkPowInv(b *testing.B) {
+	e := New(123456789)
+	for i := 0; i < b.N; i++ {
+		e = PowInv(e)
+	}
+}
+
+func BenchmarkModPowInv(b *testing.B) {
+	e := New(123456789)
+	for i := 0; i < b.N; i++ {
+		e = e.ModPow(P - 2)
+	}
+}
+
+func BenchmarkPowHalfOrder(b *testing.B) {
+	e := New(123456789)
+	for i := 0; i < b.N; i++ {
+		e = PowHalfOrder(e).Add(One)
+	}
+}
+
+func BenchmarkModPowHalfOrder(b *testing.B) {
+	e := New(123456789)
+	for i := 0; i < b.N; i++ {
+		e = e.ModPow((P - 1) / 2).Add(One)
+	}
+}
+
+func TestModPowBig(t *testing.T) {
+	rng := rand.New(rand.NewSource(532))
+	mod := new(big.Int).SetUint64(P)
+
+	exps := []*big.Int{big.NewInt(0), big.NewInt(1), new(big.Int).SetUint64(P - 1), new(big.Int).SetUint64(P)}
+	for i := 0; i < 20; i++ {
+		// Exponents of up to 256 bits
+		exps = append(exps, new(big.Int).Rand(rng, new(big.Int).Lsh(big.NewInt(1), 256)))
+	}
+
+	for _, base := range powTestValues(rng)[:40] {
+		b := new(big.Int).SetUint64(base.Value())
+		for _, exp := range exps {
+			want := new(big.Int).Exp(b, exp, mod)
+			if got := base.ModPowBig(exp); got.Value() != want.Uint64() {
+				t.Fatalf("%v^%s = %v, want %s", base, exp, got, want)
+			}
+		}
+	}
+
+	e := Generator()
+	if !e.ModPowBig(big.NewInt(-5)).Equal(e.PowSigned(-5)) {
+		t.Error("negative exponent differs from PowSigned")
+	}
+	if !Zero.ModPowBig(new(big.Int).SetUint64(P - 1)).IsZero() {
+		t.Error("0^(P-1) should be 0, not reduced to 0^0")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for 0^-1")
+		}
+	}()
+	Zero.ModPowBig(big.NewInt(-1))
+}
+
+func TestPowSigned(t *testing.T) {
+	rng := rand.New(rand.NewSource(515))
+	for i := 0; i < 50; i++ {
+		e := New(rng.Uint64())
+		if e.IsZero() {
+			continue
+		}
+		k := int64(rng.Uint32())
+		if !e.PowSigned(k).Equal(e.ModPow(uint64(k))) {
+			t.Fatalf("PowSigned(%d) differs from ModPow", k)
+		}
+		if !e.PowSigned(-k).Mul(e.PowSigned(k)).IsOne() {
+			t.Fatalf("e^-%d · e^%d != 1 for e = %v", k, k, e)
+		}
+	}
+
+	e := Generator()
+	if !e.PowSigned(-1).Equal(e.Inverse()) || !e.PowSigned(0).IsOne() {
+		t.Error("PowSigned(-1) or PowSigned(0) is wrong")
+	}
+	if got, want := e.PowSigned(math.MinInt64), e.Inverse().ModPow(1<<63); !got.Equal(want) {
+		t.Errorf("PowSigned(MinInt64) = %v, want %v", got, want)
+	}
+	if !Zero.PowSigned(3).IsZero() {
+		t.Error("0^3 should be 0")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for 0^-1")
+		}
+	}()
+	Zero.PowSigned(-1)
+}
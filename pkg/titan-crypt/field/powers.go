@@ -0,0 +1,31 @@
+package field
+
+// Powers fills dst with base^0, base^1, …, base^(len(dst)-1) using one
+// multiplication per entry. Unlike calling ModPow per index, it needs no
+// exponentiation and writes into caller-owned memory.
+func Powers(dst []Element, base Element) {
+	power := One
+	for i := range dst {
+		dst[i] = power
+		power = power.Mul(base)
+	}
+}
+
+// PowerIterator yields base^0, base^1, base^2, … one multiplication per step,
+// for walking twiddle sequences without materializing them.
+type PowerIterator struct {
+	base    Element
+	current Element
+}
+
+// NewPowerIterator returns an iterator whose first Next returns One.
+func NewPowerIterator(base Element) *PowerIterator {
+	return &PowerIterator{base: base, current: One}
+}
+
+// Next returns the current power and advances to the next one.
+func (it *PowerIterator) Next() Element {
+	power := it.current
+	it.current = it.current.Mul(it.base)
+	return power
+}
@@ -0,0 +1,44 @@
+package field
+
+import (
+	"testing"
+)
+
+func TestPowers(t *testing.T) {
+	root := PrimitiveRootOfUnity(64)
+	dst := make([]Element, 70)
+	Powers(dst, root)
+
+	it := NewPowerIterator(root)
+	for i, got := range dst {
+		if want := root.ModPow(uint64(i)); !got.Equal(want) {
+			t.Fatalf("Powers[%d] = %v, want %v", i, got, want)
+		}
+		if next := it.Next(); !next.Equal(got) {
+			t.Fatalf("iterator step %d = %v, want %v", i, next, got)
+		}
+	}
+	// ω^64 = 1 wraps the sequence
+	if !dst[64].IsOne() || !dst[65].Equal(root) {
+		t.Error("powers of a root of order 64 do not wrap after 64 steps")
+	}
+
+	Powers(nil, root)
+	zeros := make([]Element, 3)
+	Powers(zeros, Zero)
+	if !zeros[0].IsOne() || !zeros[1].IsZero() || !zeros[2].IsZero() {
+		t.Errorf("Powers of zero = %v, want [1 0 0]", zeros)
+	}
+}
+
+func TestPowersDoesNotAllocate(t *testing.T) {
+	dst := make([]Element, 1024)
+	root := PrimitiveRootOfUnity(1024)
+	if allocs := testing.AllocsPerRun(10, func() { Powers(dst, root) }); allocs != 0 {
+		t.Errorf("Powers allocated %v times per run", allocs)
+	}
+	it := NewPowerIterator(root)
+	if allocs := testing.AllocsPerRun(10, func() { it.Next() }); allocs != 0 {
+		t.Errorf("PowerIterator.Next allocated %v times per run", allocs)
+	}
+}
@@ -0,0 +1,161 @@
+package field
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Random returns a uniformly distributed element sampled from crypto/rand.
+//
+// Panics if crypto/rand fails to produce bytes.
+func Random() Element {
+	e, err := RandomFrom(rand.Reader)
+	if err != nil {
+		panic(fmt.Sprintf("field: reading from crypto/rand failed: %v", err))
+	}
+	return e
+}
+
+// RandomFrom returns a uniformly distributed element sampled from r.
+// It reads 8 bytes at a time as a little-endian uint64 and rejects values >= P,
+// which happens with probability below 2^-32, so there is no modulo bias.
+// Returns an error if reading from r fails.
+func RandomFrom(r io.Reader) (Element, error) {
+	var buf [8]byte
+	for {
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return Zero, fmt.Errorf("sampling field element: %w", err)
+		}
+		if v := binary.LittleEndian.Uint64(buf[:]); IsCanonical(v) {
+			return New(v), nil
+		}
+	}
+}
+
+// SampleFromBytes rejection-samples an element from data, deterministically: it
+// reads 8-byte little-endian chunks in order, skips any chunk >= P and returns
+// the first canonical one together with the number of bytes consumed. This is the
+// same rule as RandomFrom and SampleFromReader, so a Fiat–Shamir challenge derived
+// from hash output is uniform and reproducible across implementations.
+// Returns an error if data runs out before a canonical chunk is found.
+func SampleFromBytes(data []byte) (Element, int, error) {
+	consumed := 0
+	for len(data)-consumed >= 8 {
+		v := binary.LittleEndian.Uint64(data[consumed:])
+		consumed += 8
+		if IsCanonical(v) {
+			return New(v), consumed, nil
+		}
+	}
+	return Zero, consumed, fmt.Errorf("sampling field element: %d bytes left, need 8 after %d rejected", len(data)-consumed, consumed/8)
+}
+
+// SampleFromReader rejection-samples an element from r with the rule of
+// SampleFromBytes. It is RandomFrom, named for deterministic byte streams.
+func SampleFromReader(r io.Reader) (Element, error) {
+	return RandomFrom(r)
+}
+
+// RandomSlice returns n uniformly distributed elements sampled from crypto/rand.
+//
+// Panics if crypto/rand fails to produce bytes.
+func RandomSlice(n int) []Element {
+	elements := make([]Element, n)
+	if err := RandomFill(elements, rand.Reader); err != nil {
+		panic(fmt.Sprintf("field: reading from crypto/rand failed: %v", err))
+	}
+	return elements
+}
+
+// randomFillBatch is the number of elements RandomFill requests from its reader at once.
+const randomFillBatch = 1024
+
+// RandomFill fills dst with uniformly distributed elements sampled from r. It
+// reads up to randomFillBatch elements' worth of bytes per call to r and only
+// re-reads for the rare rejected values, yet consumes exactly the bytes that
+// len(dst) calls to RandomFrom would and produces the same elements, so it can
+// also replace SampleFromReader loops over deterministic streams.
+// Returns an error if reading from r fails; dst is then only partly filled.
+func RandomFill(dst []Element, r io.Reader) error {
+	buf := make([]byte, 8*min(len(dst), randomFillBatch))
+	for filled := 0; filled < len(dst); {
+		chunk := buf[:8*min(len(dst)-filled, randomFillBatch)]
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return fmt.Errorf("sampling field elements: %w", err)
+		}
+		for i := 0; i < len(chunk); i += 8 {
+			if v := binary.LittleEndian.Uint64(chunk[i:]); IsCanonical(v) {
+				dst[filled] = New(v)
+				filled++
+			}
+		}
+	}
+	return nil
+}
+
+// seededStream is a deterministic byte stream derived from a 32-byte seed.
+// Block i is SHA-256(seed || i) with i encoded as a little-endian uint64.
+type seededStream struct {
+	seed    [32]byte
+	counter uint64
+	block   [sha256.Size]byte
+	offset  int
+}
+
+func newSeededStream(seed [32]byte) *seededStream {
+	return &seededStream{seed: seed, offset: sha256.Size}
+}
+
+// uint64 returns the next 8 bytes of the stream as a little-endian uint64.
+func (s *seededStream) uint64() uint64 {
+	if s.offset+8 > sha256.Size {
+		var input [40]byte
+		copy(input[:32], s.seed[:])
+		binary.LittleEndian.PutUint64(input[32:], s.counter)
+		s.block = sha256.Sum256(input[:])
+		s.counter++
+		s.offset = 0
+	}
+
+	v := binary.LittleEndian.Uint64(s.block[s.offset:])
+	s.offset += 8
+	return v
+}
+
+// uniform returns a value uniformly distributed in [0, bound) using rejection sampling.
+func (s *seededStream) uniform(bound uint64) uint64 {
+	// Reject the top partial interval so every residue is equally likely
+	limit := ^uint64(0) - (^uint64(0) % bound)
+	for {
+		v := s.uint64()
+		if v < limit {
+			return v % bound
+		}
+	}
+}
+
+// PermuteIndices returns a pseudorandom permutation of [0, n) determined by seed.
+// It runs a Fisher–Yates shuffle driven by a SHA-256 counter-mode stream, so the
+// same seed always yields the same permutation on every platform.
+// For n <= 0 it returns an empty slice.
+func PermuteIndices(n int, seed [32]byte) []int {
+	if n <= 0 {
+		return []int{}
+	}
+
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+
+	stream := newSeededStream(seed)
+	for i := n - 1; i > 0; i-- {
+		j := stream.uniform(uint64(i) + 1)
+		indices[i], indices[j] = indices[j], indices[i]
+	}
+
+	return indices
+}
@@ -0,0 +1,204 @@
+package field
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestPermuteIndicesIsBijection(t *testing.T) {
+	seed := [32]byte{3, 8, 9}
+
+	for _, n := range []int{0, 1, 2, 3, 7, 64, 1000, 4096} {
+		perm := PermuteIndices(n, seed)
+		if len(perm) != n {
+			t.Fatalf("n=%d: got %d indices", n, len(perm))
+		}
+
+		seen := make([]bool, n)
+		for _, idx := range perm {
+			if idx < 0 || idx >= n {
+				t.Fatalf("n=%d: index %d out of range", n, idx)
+			}
+			if seen[idx] {
+				t.Fatalf("n=%d: index %d appears twice", n, idx)
+			}
+			seen[idx] = true
+		}
+	}
+}
+
+func TestPermuteIndicesDeterministic(t *testing.T) {
+	seed := [32]byte{0xde, 0xad, 0xbe, 0xef}
+
+	for _, n := range []int{1, 10, 1000} {
+		a := PermuteIndices(n, seed)
+		b := PermuteIndices(n, seed)
+		if !slices.Equal(a, b) {
+			t.Errorf("n=%d: identical seeds gave different permutations", n)
+		}
+	}
+}
+
+func TestPermuteIndicesSeedSensitivity(t *testing.T) {
+	a := PermuteIndices(1000, [32]byte{1})
+	b := PermuteIndices(1000, [32]byte{2})
+	if slices.Equal(a, b) {
+		t.Error("different seeds gave the same permutation")
+	}
+
+	// A shuffle of 1000 elements should essentially never be the identity
+	identity := true
+	for i, idx := range a {
+		if idx != i {
+			identity = false
+			break
+		}
+	}
+	if identity {
+		t.Error("permutation is the identity")
+	}
+}
+
+func TestPermuteIndicesNegative(t *testing.T) {
+	if perm := PermuteIndices(-5, [32]byte{}); len(perm) != 0 {
+		t.Errorf("PermuteIndices(-5) = %v, want empty", perm)
+	}
+}
+
+func TestRandomFromRejectsNonCanonical(t *testing.T) {
+	var buf bytes.Buffer
+	for _, v := range []uint64{P, ^uint64(0), 12345} {
+		_ = binary.Write(&buf, binary.LittleEndian, v)
+	}
+
+	e, err := RandomFrom(&buf)
+	if err != nil {
+		t.Fatalf("RandomFrom failed: %v", err)
+	}
+	if e.Value() != 12345 {
+		t.Errorf("RandomFrom = %v, want 12345 after rejecting two words", e)
+	}
+
+	if _, err := RandomFrom(&buf); err == nil {
+		t.Error("RandomFrom on an exhausted reader should fail")
+	}
+	if _, err := RandomFrom(bytes.NewReader([]byte{1, 2, 3})); err == nil {
+		t.Error("RandomFrom on a short reader should fail")
+	}
+}
+
+func TestRandomSlice(t *testing.T) {
+	elements := RandomSlice(1000)
+	if len(elements) != 1000 {
+		t.Fatalf("RandomSlice(1000) has length %d", len(elements))
+	}
+
+	seen := make(map[uint64]bool)
+	for _, e := range elements {
+		if !IsCanonical(e.Value()) {
+			t.Fatalf("non-canonical element %d", e.Value())
+		}
+		seen[e.Value()] = true
+	}
+	// A collision among 1000 uniform 64-bit values has probability about 2^-45.
+	if len(seen) != len(elements) {
+		t.Errorf("RandomSlice produced %d distinct values out of %d", len(seen), len(elements))
+	}
+
+	if len(RandomSlice(0)) != 0 || Random().Equal(Random()) {
+		t.Error("unexpected RandomSlice(0) length or repeated Random value")
+	}
+}
+
+func TestRandomFillMatchesRandomFrom(t *testing.T) {
+	rng := rand.New(rand.NewSource(535))
+
+	// Enough words for several batches, with frequent non-canonical ones
+	const n = 3*randomFillBatch + 17
+	var stream []byte
+	for i := 0; i < n+n/4+100; i++ {
+		v := rng.Uint64()
+		if i%5 == 0 {
+			v = P + v%(^uint64(0)-P+1)
+		}
+		stream = binary.LittleEndian.AppendUint64(stream, v)
+	}
+
+	filled := make([]Element, n)
+	fillReader := bytes.NewReader(stream)
+	if err := RandomFill(filled, fillReader); err != nil {
+		t.Fatalf("RandomFill failed: %v", err)
+	}
+
+	seqReader := bytes.NewReader(stream)
+	for i := range filled {
+		want, err := RandomFrom(seqReader)
+		if err != nil {
+			t.Fatalf("RandomFrom failed: %v", err)
+		}
+		if !filled[i].Equal(want) {
+			t.Fatalf("element %d: RandomFill %v, RandomFrom %v", i, filled[i], want)
+		}
+	}
+	if fillReader.Len() != seqReader.Len() {
+		t.Errorf("RandomFill left %d bytes unread, RandomFrom %d", fillReader.Len(), seqReader.Len())
+	}
+
+	if err := RandomFill(make([]Element, 10), bytes.NewReader(stream[:79])); err == nil {
+		t.Error("RandomFill on a short reader should fail")
+	}
+	if err := RandomFill(nil, bytes.NewReader(nil)); err != nil {
+		t.Errorf("RandomFill of an empty slice failed: %v", err)
+	}
+}
+
+func BenchmarkRandomFill65536(b *testing.B) {
+	dst := make([]Element, 1<<16)
+	for i := 0; i < b.N; i++ {
+		if err := RandomFill(dst, cryptorand.Reader); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRandomFromLoop65536(b *testing.B) {
+	dst := make([]Element, 1<<16)
+	for i := 0; i < b.N; i++ {
+		for j := range dst {
+			dst[j], _ = RandomFrom(cryptorand.Reader)
+		}
+	}
+}
+
+func TestSampleFromBytes(t *testing.T) {
+	var data []byte
+	for _, v := range []uint64{P, ^uint64(0), 42, 7} {
+		data = binary.LittleEndian.AppendUint64(data, v)
+	}
+
+	e, n, err := SampleFromBytes(data)
+	if err != nil || e.Value() != 42 || n != 24 {
+		t.Fatalf("SampleFromBytes = %v, %d, %v; want 42 after 24 bytes", e, n, err)
+	}
+	e, n, err = SampleFromBytes(data[n:])
+	if err != nil || e.Value() != 7 || n != 8 {
+		t.Fatalf("second sample = %v, %d, %v; want 7 after 8 bytes", e, n, err)
+	}
+
+	// Matches the reader-based sampler
+	fromReader, err := SampleFromReader(bytes.NewReader(data))
+	if err != nil || fromReader.Value() != 42 {
+		t.Errorf("SampleFromReader = %v, %v; want 42", fromReader, err)
+	}
+
+	if _, _, err := SampleFromBytes(data[:16]); err == nil {
+		t.Error("only non-canonical chunks should fail")
+	}
+	if _, _, err := SampleFromBytes([]byte{1, 2, 3}); err == nil {
+		t.Error("fewer than 8 bytes should fail")
+	}
+}
@@ -0,0 +1,11 @@
+//go:build barrett
+
+package field
+
+// montyred maps a 128-bit product of Montgomery forms back to Montgomery form,
+// returning x·R⁻¹ mod P for x < P·2^64. The barrett build tag selects this
+// backend, which computes the same values as the default Montgomery reduction
+// with two generic Barrett reductions.
+func montyred(x uint128) uint64 {
+	return barrettMontyred(x)
+}
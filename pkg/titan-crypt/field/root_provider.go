@@ -0,0 +1,141 @@
+package field
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// RootProvider memoizes the powers of primitive roots of unity by order, so that
+// NTT, LDE and domain code working over several related sizes computes each root
+// table only once. Each order has a forward and an inverse table, plus the
+// half-size bit-reversed twiddle tables that radix-2 transforms consume
+// sequentially. It is safe for concurrent use. Tables are built without holding
+// the lock, so building a large one does not block readers of cached orders;
+// concurrent first requests for an order may each build it, and all of them
+// receive the first table stored.
+//
+// Returned slices are shared between all callers and must not be modified.
+type RootProvider struct {
+	mu            sync.RWMutex
+	powers        map[uint64][]Element
+	inversePowers map[uint64][]Element
+
+	reversedPowers        map[uint64][]Element
+	reversedInversePowers map[uint64][]Element
+}
+
+// DefaultRootProvider is the provider consulted by the ntt package, whose
+// transforms read the bit-reversed tables.
+var DefaultRootProvider = NewRootProvider()
+
+// NewRootProvider returns an empty RootProvider.
+func NewRootProvider() *RootProvider {
+	return &RootProvider{
+		powers:        make(map[uint64][]Element),
+		inversePowers: make(map[uint64][]Element),
+
+		reversedPowers:        make(map[uint64][]Element),
+		reversedInversePowers: make(map[uint64][]Element),
+	}
+}
+
+// RootPowers returns [ω⁰, ω¹, …, ω^(order-1)] where ω = GetPrimitiveRoot(order).
+func (p *RootProvider) RootPowers(order uint64) ([]Element, error) {
+	return p.lookup(p.powers, order, false)
+}
+
+// InverseRootPowers returns [ω⁰, ω⁻¹, …, ω^-(order-1)] where ω = GetPrimitiveRoot(order).
+func (p *RootProvider) InverseRootPowers(order uint64) ([]Element, error) {
+	return p.lookup(p.inversePowers, order, true)
+}
+
+// BitReversedRootPowers returns the order/2 twiddles of a radix-2 transform of
+// length order: index i holds ω^rev(i), where ω = GetPrimitiveRoot(order) and rev
+// reverses the low log2(order/2) bits. These are the powers ω⁰ … ω^(order/2-1)
+// permuted; the upper half of RootPowers is their negation and is not stored.
+// The table is built directly from ω, without materializing RootPowers(order).
+// order must be a power of 2; order 1 yields an empty table.
+func (p *RootProvider) BitReversedRootPowers(order uint64) ([]Element, error) {
+	return p.lookupReversed(p.reversedPowers, order, false)
+}
+
+// BitReversedInverseRootPowers is BitReversedRootPowers for ω⁻¹.
+func (p *RootProvider) BitReversedInverseRootPowers(order uint64) ([]Element, error) {
+	return p.lookupReversed(p.reversedInversePowers, order, true)
+}
+
+func (p *RootProvider) lookupReversed(cache map[uint64][]Element, order uint64, inverse bool) ([]Element, error) {
+	p.mu.RLock()
+	if powers, ok := cache[order]; ok {
+		p.mu.RUnlock()
+		return powers, nil
+	}
+	p.mu.RUnlock()
+
+	root, err := GetPrimitiveRoot(order)
+	if err != nil {
+		return nil, err
+	}
+
+	if inverse {
+		root = root.Inverse()
+	}
+	powers := make([]Element, order/2)
+	if len(powers) > 0 {
+		shift := 64 - bits.TrailingZeros64(order/2)
+		power := One
+		for k := range powers {
+			// A shift of 64 (order 2) yields 0 in Go, as required
+			powers[bits.Reverse64(uint64(k))>>shift] = power
+			power = power.Mul(root)
+		}
+	}
+
+	return p.store(cache, order, powers), nil
+}
+
+func (p *RootProvider) lookup(cache map[uint64][]Element, order uint64, inverse bool) ([]Element, error) {
+	p.mu.RLock()
+	if powers, ok := cache[order]; ok {
+		p.mu.RUnlock()
+		return powers, nil
+	}
+	forward, haveForward := p.powers[order]
+	p.mu.RUnlock()
+
+	root, err := GetPrimitiveRoot(order)
+	if err != nil {
+		return nil, err
+	}
+
+	powers := make([]Element, order)
+	powers[0] = One
+	if inverse && haveForward {
+		// ω^-k = ω^(order-k): reuse the forward table instead of inverting
+		for k := uint64(1); k < order; k++ {
+			powers[k] = forward[order-k]
+		}
+	} else {
+		if inverse {
+			root = root.Inverse()
+		}
+		for k := uint64(1); k < order; k++ {
+			powers[k] = powers[k-1].Mul(root)
+		}
+	}
+
+	return p.store(cache, order, powers), nil
+}
+
+// store caches powers for order unless another caller got there first, and
+// returns the cached table.
+func (p *RootProvider) store(cache map[uint64][]Element, order uint64, powers []Element) []Element {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if cached, ok := cache[order]; ok {
+		return cached
+	}
+	cache[order] = powers
+	return powers
+}
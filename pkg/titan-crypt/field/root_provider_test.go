@@ -0,0 +1,212 @@
+package field
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestRootProviderPowers(t *testing.T) {
+	p := NewRootProvider()
+
+	for _, order := range []uint64{1, 2, 8, 1024} {
+		powers, err := p.RootPowers(order)
+		if err != nil {
+			t.Fatalf("RootPowers(%d) failed: %v", order, err)
+		}
+		inverse, err := p.InverseRootPowers(order)
+		if err != nil {
+			t.Fatalf("InverseRootPowers(%d) failed: %v", order, err)
+		}
+		if uint64(len(powers)) != order || uint64(len(inverse)) != order {
+			t.Fatalf("order %d: got %d powers and %d inverse powers", order, len(powers), len(inverse))
+		}
+
+		for k := uint64(0); k < order; k++ {
+			want, _ := GetNthRootOfUnity(order, k)
+			if !powers[k].Equal(want) {
+				t.Errorf("order %d: powers[%d] = %v, want %v", order, k, powers[k], want)
+			}
+			if !powers[k].Mul(inverse[k]).IsOne() {
+				t.Errorf("order %d: inverse[%d] is not the inverse of powers[%d]", order, k, k)
+			}
+		}
+	}
+}
+
+func TestRootProviderInverseFirst(t *testing.T) {
+	// Requesting inverse powers before forward ones must not change the result
+	p := NewRootProvider()
+	inverse, err := p.InverseRootPowers(64)
+	if err != nil {
+		t.Fatalf("InverseRootPowers failed: %v", err)
+	}
+	root, _ := GetInversePrimitiveRoot(64)
+	for k, got := range inverse {
+		if want := root.ModPow(uint64(k)); !got.Equal(want) {
+			t.Errorf("inverse[%d] = %v, want %v", k, got, want)
+		}
+	}
+}
+
+func TestRootProviderMemoizes(t *testing.T) {
+	p := NewRootProvider()
+	a, _ := p.RootPowers(256)
+	b, _ := p.RootPowers(256)
+	if &a[0] != &b[0] {
+		t.Error("RootPowers recomputed a cached table")
+	}
+
+	c, _ := p.InverseRootPowers(256)
+	d, _ := p.InverseRootPowers(256)
+	if &c[0] != &d[0] {
+		t.Error("InverseRootPowers recomputed a cached table")
+	}
+}
+
+func TestRootProviderConcurrent(t *testing.T) {
+	p := NewRootProvider()
+
+	const workers = 16
+	results := make([][]Element, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			var err error
+			if w%2 == 0 {
+				results[w], err = p.RootPowers(4096)
+			} else {
+				_, err = p.InverseRootPowers(4096)
+				if err == nil {
+					results[w], err = p.RootPowers(4096)
+				}
+			}
+			if err != nil {
+				t.Errorf("worker %d: %v", w, err)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for w := 1; w < workers; w++ {
+		if &results[w][0] != &results[0][0] {
+			t.Fatalf("worker %d received a different table", w)
+		}
+	}
+}
+
+func TestRootProviderBitReversed(t *testing.T) {
+	p := NewRootProvider()
+	reference := NewRootProvider()
+
+	for _, order := range []uint64{1, 2, 8, 1024} {
+		natural, _ := reference.RootPowers(order)
+		naturalInv, _ := reference.InverseRootPowers(order)
+		reversed, err := p.BitReversedRootPowers(order)
+		if err != nil {
+			t.Fatalf("BitReversedRootPowers(%d) failed: %v", order, err)
+		}
+		reversedInv, err := p.BitReversedInverseRootPowers(order)
+		if err != nil {
+			t.Fatalf("BitReversedInverseRootPowers(%d) failed: %v", order, err)
+		}
+		if uint64(len(reversed)) != order/2 || uint64(len(reversedInv)) != order/2 {
+			t.Fatalf("order %d: tables have %d and %d entries, want %d", order, len(reversed), len(reversedInv), order/2)
+		}
+
+		logHalf := 0
+		for 2<<logHalf < order {
+			logHalf++
+		}
+		for i := uint64(0); i < order/2; i++ {
+			// Reverse the low logHalf bits of i by hand
+			var rev uint64
+			for b := 0; b < logHalf; b++ {
+				rev |= (i >> b & 1) << (logHalf - 1 - b)
+			}
+			if !reversed[i].Equal(natural[rev]) || !reversedInv[i].Equal(naturalInv[rev]) {
+				t.Fatalf("order %d: index %d does not hold power %d", order, i, rev)
+			}
+		}
+	}
+
+	// The reversed tables are built directly from the root
+	if len(p.powers) != 0 || len(p.inversePowers) != 0 {
+		t.Error("bit-reversed lookups built natural tables")
+	}
+
+	a, _ := p.BitReversedRootPowers(256)
+	b, _ := p.BitReversedRootPowers(256)
+	if &a[0] != &b[0] {
+		t.Error("BitReversedRootPowers recomputed a cached table")
+	}
+
+	// An inverse request builds only the inverse bit-reversed table
+	inverseOnly := NewRootProvider()
+	if _, err := inverseOnly.BitReversedInverseRootPowers(64); err != nil {
+		t.Fatal(err)
+	}
+	if len(inverseOnly.powers) != 0 || len(inverseOnly.inversePowers) != 0 || len(inverseOnly.reversedPowers) != 0 {
+		t.Error("BitReversedInverseRootPowers built another table")
+	}
+
+	// A cold provider serves the reversed tables concurrently
+	cold := NewRootProvider()
+	var wg sync.WaitGroup
+	results := make([][]Element, 8)
+	for w := range results {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			results[w], _ = cold.BitReversedInverseRootPowers(4096)
+		}(w)
+	}
+	wg.Wait()
+	for w := 1; w < len(results); w++ {
+		if &results[w][0] != &results[0][0] {
+			t.Fatalf("worker %d received a different table", w)
+		}
+	}
+
+	if _, err := p.BitReversedRootPowers(12); err == nil {
+		t.Error("expected error for non-power-of-2 order")
+	}
+}
+
+func TestRootProviderErrors(t *testing.T) {
+	p := NewRootProvider()
+	if _, err := p.RootPowers(0); err == nil {
+		t.Error("expected error for order 0")
+	}
+	if _, err := p.InverseRootPowers(12); err == nil {
+		t.Error("expected error for non-power-of-2 order")
+	}
+}
+
+// benchmarkAscendingDomains requests the root tables for five domains of sizes
+// 2^14..2^18, as a prover building ascending domains would, twice over.
+func benchmarkAscendingDomains(b *testing.B, provider func() *RootProvider) {
+	for i := 0; i < b.N; i++ {
+		p := provider()
+		for round := 0; round < 2; round++ {
+			for logN := 14; logN <= 18; logN++ {
+				if _, err := p.RootPowers(1 << logN); err != nil {
+					b.Fatal(err)
+				}
+				if _, err := p.InverseRootPowers(1 << logN); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkRootProviderAscendingDomainsCold(b *testing.B) {
+	benchmarkAscendingDomains(b, NewRootProvider)
+}
+
+func BenchmarkRootProviderAscendingDomainsShared(b *testing.B) {
+	shared := NewRootProvider()
+	benchmarkAscendingDomains(b, func() *RootProvider { return shared })
+}
@@ -3,9 +3,12 @@ package field
 
 import (
 	"fmt"
+	"math/bits"
+	"sync"
 )
 
 // PrimitiveRoots contains precomputed primitive roots of unity.
+// The values are canonical (not Montgomery form) and must be converted with New.
 // These are equivalent to twenty-first's PRIMITIVE_ROOTS map.
 var PrimitiveRoots = map[uint64]uint64{
 	0:          1,
@@ -45,7 +48,8 @@ var PrimitiveRoots = map[uint64]uint64{
 }
 
 // GetPrimitiveRoot returns the primitive root of unity for the given order.
-// The order must be a power of 2.
+// The order must be a power of 2, as the NTT domain helpers built on it assume;
+// use GeneratePrimitiveRoot for other orders dividing P-1.
 func GetPrimitiveRoot(order uint64) (Element, error) {
 	if order == 0 {
 		return Zero, fmt.Errorf("order cannot be zero")
@@ -58,10 +62,10 @@ func GetPrimitiveRoot(order uint64) (Element, error) {
 
 	// Check if we have the primitive root for this order
 	if root, exists := PrimitiveRoots[order]; exists {
-		return NewFromRaw(root), nil
+		return New(root), nil
 	}
 
-	return Zero, fmt.Errorf("primitive root not found for order %d", order)
+	return GeneratePrimitiveRoot(order)
 }
 
 // PrimitiveRootOfUnity returns the primitive root of unity for the given order.
@@ -75,31 +79,31 @@ func PrimitiveRootOfUnity(order uint64) Element {
 	return root
 }
 
+// groupOrderPrimes are the distinct prime factors of the multiplicative group
+// order P-1 = 2^32 · 3 · 5 · 17 · 257 · 65537.
+var groupOrderPrimes = [...]uint64{2, 3, 5, 17, 257, 65537}
+
 // IsPrimitiveRootOfUnity checks if the given element is a primitive root of unity of the given order.
+// Any order dividing P-1 is supported; for other orders no primitive root exists
+// and false is returned.
 func IsPrimitiveRootOfUnity(element Element, order uint64) bool {
-	if order == 0 {
-		return false
-	}
-
-	// Check if order is a power of 2
-	if order&(order-1) != 0 {
+	if order == 0 || (P-1)%order != 0 {
 		return false
 	}
 
 	// A primitive root of unity of order n satisfies:
 	// 1. element^n ≡ 1 (mod P)
-	// 2. element^(n/2) ≢ 1 (mod P) for all proper divisors of n
+	// 2. element^(n/q) ≢ 1 (mod P) for every prime q dividing n,
+	//    which rules out every proper divisor of n as the element's order
 
 	// Check condition 1: element^n ≡ 1
 	if !element.ModPow(order).Equal(One) {
 		return false
 	}
 
-	// Check condition 2: element^(n/2) ≢ 1 for all proper divisors
-	// We only need to check the largest proper divisor: n/2
-	if order > 1 {
-		halfOrder := order / 2
-		if element.ModPow(halfOrder).Equal(One) {
+	// Check condition 2 for the prime factors of n, all of which divide P-1
+	for _, q := range groupOrderPrimes {
+		if order%q == 0 && element.ModPow(order/q).Equal(One) {
 			return false
 		}
 	}
@@ -107,29 +111,76 @@ func IsPrimitiveRootOfUnity(element Element, order uint64) bool {
 	return true
 }
 
-// GeneratePrimitiveRoot generates a primitive root of unity for the given order.
-// This is a more expensive operation and should be used sparingly.
+// MultiplicativeOrder returns the least n > 0 with e^n = 1. The order divides
+// P-1, so it is found by starting from P-1 and dividing out each prime factor
+// q of P-1 while e^(n/q) is still 1: at most 37 exponentiations. Zero has no
+// multiplicative order and yields 0.
+func (e Element) MultiplicativeOrder() uint64 {
+	if e.IsZero() {
+		return 0
+	}
+
+	order := P - 1
+	for _, q := range groupOrderPrimes {
+		for order%q == 0 && e.ModPow(order/q).IsOne() {
+			order /= q
+		}
+	}
+	return order
+}
+
+// generatedRoots memoizes GeneratePrimitiveRoot results for orders missing from PrimitiveRoots.
+var (
+	generatedRoots   = make(map[uint64]Element)
+	generatedRootsMu sync.RWMutex
+)
+
+// GeneratePrimitiveRoot returns a primitive root of unity of the given order,
+// computed as Generator()^((P-1)/order) and checked with IsPrimitiveRootOfUnity.
+// Any order dividing P-1 = 2^32 · 3 · 5 · 17 · 257 · 65537 is supported, e.g. 3 or
+// 5 for small-subgroup checks or 3·2^k for mixed-radix transforms.
+// PrimitiveRoots holds exactly these values for powers of 2, so it is consulted
+// first; any other result is memoized.
+//
+// Returns an error if order is zero or does not divide P-1.
 func GeneratePrimitiveRoot(order uint64) (Element, error) {
 	if order == 0 {
 		return Zero, fmt.Errorf("order cannot be zero")
 	}
-
-	// Check if order is a power of 2
-	if order&(order-1) != 0 {
-		return Zero, fmt.Errorf("order must be a power of 2, got %d", order)
+	if (P-1)%order != 0 {
+		return Zero, fmt.Errorf("order %d does not divide P-1", order)
 	}
 
-	// For small orders, we can use the precomputed values
 	if root, exists := PrimitiveRoots[order]; exists {
-		return NewFromRaw(root), nil
+		return New(root), nil
+	}
+
+	generatedRootsMu.RLock()
+	root, exists := generatedRoots[order]
+	generatedRootsMu.RUnlock()
+	if exists {
+		return root, nil
+	}
+
+	root, err := computePrimitiveRoot(order)
+	if err != nil {
+		return Zero, err
 	}
 
-	// For larger orders, we need to generate them
-	// This is a complex operation that requires finding a generator
-	// of the multiplicative group of the field
+	generatedRootsMu.Lock()
+	generatedRoots[order] = root
+	generatedRootsMu.Unlock()
+	return root, nil
+}
 
-	// For now, return an error for unsupported orders
-	return Zero, fmt.Errorf("primitive root generation not implemented for order %d", order)
+// computePrimitiveRoot returns Generator()^((P-1)/order) for an order dividing P-1.
+// Since the generator has order P-1, the result has order exactly order.
+func computePrimitiveRoot(order uint64) (Element, error) {
+	root := Generator().ModPow((P - 1) / order)
+	if !IsPrimitiveRootOfUnity(root, order) {
+		return Zero, fmt.Errorf("generated root %v is not a primitive root of unity of order %d", root, order)
+	}
+	return root, nil
 }
 
 // GetInversePrimitiveRoot returns the inverse of the primitive root of unity.
@@ -165,3 +216,108 @@ func GetInverseNthRootOfUnity(order uint64, n uint64) (Element, error) {
 
 	return root.Inverse(), nil
 }
+
+// ElementToDomainIndex returns the discrete logarithm of e with respect to the
+// primitive root of unity of the given order, i.e. the unique k in [0, order)
+// such that GetPrimitiveRoot(order)^k == e.
+//
+// Returns an error if order is not a supported power of 2 or if e does not lie
+// in the subgroup of that order. The search is a baby-step giant-step walk using
+// O(sqrt(order)) multiplications and memory.
+func ElementToDomainIndex(e Element, order uint64) (uint64, error) {
+	root, err := GetPrimitiveRoot(order)
+	if err != nil {
+		return 0, err
+	}
+
+	if !e.ModPow(order).IsOne() {
+		return 0, fmt.Errorf("element %v is not in the subgroup of order %d", e, order)
+	}
+
+	// m = 2^ceil(log2(order)/2), so m*m >= order
+	logOrder := bits.TrailingZeros64(order)
+	m := uint64(1) << ((logOrder + 1) / 2)
+	if m > order {
+		m = order
+	}
+
+	// Baby steps: root^j for j in [0, m)
+	babySteps := make(map[Element]uint64, m)
+	power := One
+	for j := uint64(0); j < m; j++ {
+		babySteps[power] = j
+		power = power.Mul(root)
+	}
+
+	// Giant steps: e * root^(-m*i) for i in [0, order/m)
+	giant := root.ModPow(order - m)
+	gamma := e
+	for i := uint64(0); i < order/m; i++ {
+		if j, ok := babySteps[gamma]; ok {
+			return i*m + j, nil
+		}
+		gamma = gamma.Mul(giant)
+	}
+
+	return 0, fmt.Errorf("discrete logarithm of %v not found for order %d", e, order)
+}
+
+// StridedRootPowers returns ω^(stride·i) for i in [0, count), where ω is the
+// primitive root of unity of the given order.
+//
+// Only one exponentiation is performed; every further power costs a single
+// multiplication. Element i equals GetNthRootOfUnity(order, (stride*i) % order).
+func StridedRootPowers(order, stride, count uint64) ([]Element, error) {
+	root, err := GetPrimitiveRoot(order)
+	if err != nil {
+		return nil, err
+	}
+
+	step := root.ModPow(stride % order)
+	powers := make([]Element, count)
+	acc := One
+	for i := range powers {
+		powers[i] = acc
+		acc = acc.Mul(step)
+	}
+
+	return powers, nil
+}
+
+// HalveDomain steps from the subgroup of the given order to its subgroup of half
+// the order, returning order/2 and its primitive root ω², where ω = GetPrimitiveRoot(order).
+// The table of primitive roots is consistent under squaring, so ω² equals
+// GetPrimitiveRoot(order/2).
+//
+// Returns an error if order is not a supported power of 2 or is 1.
+func HalveDomain(order uint64) (uint64, Element, error) {
+	root, err := GetPrimitiveRoot(order)
+	if err != nil {
+		return 0, Zero, err
+	}
+	if order == 1 {
+		return 0, Zero, fmt.Errorf("cannot halve the trivial domain of order 1")
+	}
+	return order / 2, root.Square(), nil
+}
+
+// DoubleDomain steps from the subgroup of the given order to the subgroup of twice
+// the order containing it, returning 2·order and GetPrimitiveRoot(2·order), whose
+// square is the primitive root of the current order.
+//
+// Returns an error if order is not a supported power of 2 or if 2·order exceeds
+// the field's two-adicity bound of 2^32.
+func DoubleDomain(order uint64) (uint64, Element, error) {
+	if _, err := GetPrimitiveRoot(order); err != nil {
+		return 0, Zero, err
+	}
+	if order >= 1<<MaxTwoAdicity {
+		return 0, Zero, fmt.Errorf("cannot double domain of order %d: the largest two-adic subgroup has order 2^32", order)
+	}
+
+	root, err := GetPrimitiveRoot(2 * order)
+	if err != nil {
+		return 0, Zero, err
+	}
+	return 2 * order, root, nil
+}
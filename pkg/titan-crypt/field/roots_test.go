@@ -0,0 +1,329 @@
+package field
+
+import (
+	"testing"
+)
+
+func TestPrimitiveRootsTable(t *testing.T) {
+	// Every entry of the table must be a primitive root of unity of its order
+	for order := range PrimitiveRoots {
+		if order == 0 {
+			continue
+		}
+
+		root, err := GetPrimitiveRoot(order)
+		if err != nil {
+			t.Fatalf("GetPrimitiveRoot(%d) failed: %v", order, err)
+		}
+		if !IsPrimitiveRootOfUnity(root, order) {
+			t.Errorf("GetPrimitiveRoot(%d) = %v is not a primitive root of unity", order, root)
+		}
+	}
+}
+
+// TestGetPrimitiveRootOrder guards against decoding the canonical table values
+// as raw Montgomery words, which yields elements that are not roots of unity of
+// the requested order.
+func TestGetPrimitiveRootOrder(t *testing.T) {
+	for order := range PrimitiveRoots {
+		if order == 0 {
+			continue
+		}
+
+		root, err := GetPrimitiveRoot(order)
+		if err != nil {
+			t.Fatalf("GetPrimitiveRoot(%d) failed: %v", order, err)
+		}
+		if !root.ModPow(order).IsOne() {
+			t.Errorf("GetPrimitiveRoot(%d)^%d != 1", order, order)
+		}
+		if order > 1 && root.ModPow(order/2).IsOne() {
+			t.Errorf("GetPrimitiveRoot(%d)^%d == 1", order, order/2)
+		}
+	}
+}
+
+func TestElementToDomainIndex(t *testing.T) {
+	const order = 1024
+	root, err := GetPrimitiveRoot(order)
+	if err != nil {
+		t.Fatalf("GetPrimitiveRoot failed: %v", err)
+	}
+
+	for _, k := range []uint64{0, 1, 2, 31, 32, 33, 511, 512, 513, 1000, 1023} {
+		e := root.ModPow(k)
+
+		index, err := ElementToDomainIndex(e, order)
+		if err != nil {
+			t.Fatalf("ElementToDomainIndex(root^%d) failed: %v", k, err)
+		}
+		if index != k {
+			t.Errorf("ElementToDomainIndex(root^%d) = %d, want %d", k, index, k)
+		}
+		if !root.ModPow(index).Equal(e) {
+			t.Errorf("root^%d != e", index)
+		}
+	}
+}
+
+func TestElementToDomainIndexSmallOrders(t *testing.T) {
+	for _, order := range []uint64{1, 2, 4, 8} {
+		root, err := GetPrimitiveRoot(order)
+		if err != nil {
+			t.Fatalf("GetPrimitiveRoot(%d) failed: %v", order, err)
+		}
+
+		for k := uint64(0); k < order; k++ {
+			index, err := ElementToDomainIndex(root.ModPow(k), order)
+			if err != nil {
+				t.Fatalf("order %d: ElementToDomainIndex(root^%d) failed: %v", order, k, err)
+			}
+			if index != k {
+				t.Errorf("order %d: ElementToDomainIndex(root^%d) = %d", order, k, index)
+			}
+		}
+	}
+}
+
+func TestElementToDomainIndexNotInSubgroup(t *testing.T) {
+	// 2 is not a 1024-th root of unity
+	if _, err := ElementToDomainIndex(New(2), 1024); err == nil {
+		t.Error("expected error for element outside the subgroup")
+	}
+
+	// A primitive 2048-th root lies outside the subgroup of order 1024
+	root2048, _ := GetPrimitiveRoot(2048)
+	if _, err := ElementToDomainIndex(root2048, 1024); err == nil {
+		t.Error("expected error for root of larger order")
+	}
+
+	if _, err := ElementToDomainIndex(Zero, 1024); err == nil {
+		t.Error("expected error for zero")
+	}
+
+	if _, err := ElementToDomainIndex(One, 1000); err == nil {
+		t.Error("expected error for non-power-of-2 order")
+	}
+}
+
+func TestStridedRootPowers(t *testing.T) {
+	for _, order := range []uint64{1, 8, 1024} {
+		for _, stride := range []uint64{0, 1, 2, 3, order + 3} {
+			count := 2*order + 5
+			powers, err := StridedRootPowers(order, stride, count)
+			if err != nil {
+				t.Fatalf("StridedRootPowers(%d, %d, %d) failed: %v", order, stride, count, err)
+			}
+			if uint64(len(powers)) != count {
+				t.Fatalf("got %d powers, want %d", len(powers), count)
+			}
+
+			for i, got := range powers {
+				want, err := GetNthRootOfUnity(order, (stride*uint64(i))%order)
+				if err != nil {
+					t.Fatalf("GetNthRootOfUnity failed: %v", err)
+				}
+				if !got.Equal(want) {
+					t.Errorf("order %d, stride %d: powers[%d] = %v, want %v", order, stride, i, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestStridedRootPowersErrors(t *testing.T) {
+	if _, err := StridedRootPowers(0, 1, 4); err == nil {
+		t.Error("expected error for order 0")
+	}
+	if _, err := StridedRootPowers(12, 1, 4); err == nil {
+		t.Error("expected error for non-power-of-2 order")
+	}
+
+	powers, err := StridedRootPowers(16, 3, 0)
+	if err != nil || len(powers) != 0 {
+		t.Errorf("count 0 should yield an empty slice, got %v, %v", powers, err)
+	}
+}
+
+func TestHalveDomain(t *testing.T) {
+	for order := uint64(2); order <= 1<<32; order *= 2 {
+		half, root, err := HalveDomain(order)
+		if err != nil {
+			t.Fatalf("HalveDomain(%d) failed: %v", order, err)
+		}
+		if half != order/2 {
+			t.Errorf("HalveDomain(%d) order = %d, want %d", order, half, order/2)
+		}
+
+		current, _ := GetPrimitiveRoot(order)
+		if !root.Equal(current.Square()) {
+			t.Errorf("HalveDomain(%d) root is not the square of the current root", order)
+		}
+		if !IsPrimitiveRootOfUnity(root, half) {
+			t.Errorf("HalveDomain(%d) root is not a primitive %d-th root of unity", order, half)
+		}
+	}
+}
+
+func TestDoubleDomain(t *testing.T) {
+	for order := uint64(1); order < 1<<32; order *= 2 {
+		double, root, err := DoubleDomain(order)
+		if err != nil {
+			t.Fatalf("DoubleDomain(%d) failed: %v", order, err)
+		}
+		if double != 2*order {
+			t.Errorf("DoubleDomain(%d) order = %d, want %d", order, double, 2*order)
+		}
+		if !IsPrimitiveRootOfUnity(root, double) {
+			t.Errorf("DoubleDomain(%d) root is not a primitive %d-th root of unity", order, double)
+		}
+
+		current, _ := GetPrimitiveRoot(order)
+		if !root.Square().Equal(current) {
+			t.Errorf("DoubleDomain(%d) root does not square to the current root", order)
+		}
+
+		// Halving undoes doubling
+		back, backRoot, err := HalveDomain(double)
+		if err != nil || back != order || !backRoot.Equal(current) {
+			t.Errorf("HalveDomain(DoubleDomain(%d)) = %d, %v, %v", order, back, backRoot, err)
+		}
+	}
+}
+
+func TestDomainNavigationBoundaries(t *testing.T) {
+	if _, _, err := HalveDomain(1); err == nil {
+		t.Error("HalveDomain(1) should fail")
+	}
+	if _, _, err := DoubleDomain(1 << 32); err == nil {
+		t.Error("DoubleDomain(2^32) should fail")
+	}
+	for _, order := range []uint64{0, 3, 12, 1 << 33} {
+		if _, _, err := HalveDomain(order); err == nil {
+			t.Errorf("HalveDomain(%d) should fail", order)
+		}
+		if _, _, err := DoubleDomain(order); err == nil {
+			t.Errorf("DoubleDomain(%d) should fail", order)
+		}
+	}
+}
+
+func TestGeneratePrimitiveRootMatchesTable(t *testing.T) {
+	for order, want := range PrimitiveRoots {
+		if order == 0 {
+			continue
+		}
+		got, err := computePrimitiveRoot(order)
+		if err != nil {
+			t.Fatalf("computePrimitiveRoot(%d) failed: %v", order, err)
+		}
+		if got.Value() != want {
+			t.Errorf("computePrimitiveRoot(%d) = %v, table has %d", order, got, want)
+		}
+	}
+}
+
+func TestGeneratePrimitiveRootMemoizesMissingOrders(t *testing.T) {
+	const order = 1024
+	saved := PrimitiveRoots[order]
+	delete(PrimitiveRoots, order)
+	defer func() { PrimitiveRoots[order] = saved }()
+
+	root, err := GetPrimitiveRoot(order)
+	if err != nil {
+		t.Fatalf("GetPrimitiveRoot(%d) without a table entry failed: %v", order, err)
+	}
+	if root.Value() != saved {
+		t.Errorf("generated root %v, want %d", root, saved)
+	}
+
+	generatedRootsMu.RLock()
+	memo, ok := generatedRoots[order]
+	generatedRootsMu.RUnlock()
+	if !ok || !memo.Equal(root) {
+		t.Errorf("root of order %d was not memoized", order)
+	}
+}
+
+func TestGeneratePrimitiveRootErrors(t *testing.T) {
+	for _, order := range []uint64{0, 7, 25, 1 << 33, 1 << 63} {
+		if _, err := GeneratePrimitiveRoot(order); err == nil {
+			t.Errorf("GeneratePrimitiveRoot(%d) should fail", order)
+		}
+	}
+}
+
+func TestGeneratePrimitiveRootArbitraryOrders(t *testing.T) {
+	for _, order := range []uint64{3, 5, 15, 17, 257, 65537, 3 << 10, 5 * 17 << 4, P - 1} {
+		root, err := GeneratePrimitiveRoot(order)
+		if err != nil {
+			t.Fatalf("GeneratePrimitiveRoot(%d) failed: %v", order, err)
+		}
+		if !IsPrimitiveRootOfUnity(root, order) {
+			t.Errorf("GeneratePrimitiveRoot(%d) = %v is not primitive", order, root)
+		}
+	}
+
+	// Order 3: ω ≠ 1, ω³ = 1 and 1 + ω + ω² = 0.
+	omega, _ := GeneratePrimitiveRoot(3)
+	if omega.IsOne() || !One.Add(omega).Add(omega.Square()).IsZero() {
+		t.Errorf("order-3 root %v does not satisfy 1 + ω + ω² = 0", omega)
+	}
+
+	// The generator itself has order P-1.
+	if !IsPrimitiveRootOfUnity(Generator(), P-1) {
+		t.Error("Generator() should be a primitive root of order P-1")
+	}
+}
+
+func TestIsPrimitiveRootOfUnityRejectsNonPrimitive(t *testing.T) {
+	root15, _ := GeneratePrimitiveRoot(15)
+	// root15^3 has order 5, root15^5 has order 3
+	if IsPrimitiveRootOfUnity(root15.ModPow(3), 15) || IsPrimitiveRootOfUnity(root15.ModPow(5), 15) {
+		t.Error("roots of proper divisor orders were accepted as primitive of order 15")
+	}
+	if !IsPrimitiveRootOfUnity(root15.ModPow(3), 5) || !IsPrimitiveRootOfUnity(root15.ModPow(5), 3) {
+		t.Error("powers of a primitive 15th root have the wrong orders")
+	}
+	if IsPrimitiveRootOfUnity(One, 7) || IsPrimitiveRootOfUnity(One, 0) {
+		t.Error("orders not dividing P-1 must be rejected")
+	}
+}
+
+func TestMultiplicativeOrder(t *testing.T) {
+	if got := Zero.MultiplicativeOrder(); got != 0 {
+		t.Errorf("Zero.MultiplicativeOrder() = %d, want 0", got)
+	}
+	if got := One.MultiplicativeOrder(); got != 1 {
+		t.Errorf("One.MultiplicativeOrder() = %d, want 1", got)
+	}
+	if got := Max.MultiplicativeOrder(); got != 2 {
+		t.Errorf("Max.MultiplicativeOrder() = %d, want 2", got)
+	}
+	if got := Generator().MultiplicativeOrder(); got != P-1 {
+		t.Errorf("Generator().MultiplicativeOrder() = %d, want P-1", got)
+	}
+
+	for _, n := range []uint64{3, 5, 15, 17 << 5, 257 * 3, 65537, 1 << 32, (P - 1) / 2} {
+		root, err := GeneratePrimitiveRoot(n)
+		if err != nil {
+			t.Fatalf("GeneratePrimitiveRoot(%d) failed: %v", n, err)
+		}
+		if got := root.MultiplicativeOrder(); got != n {
+			t.Errorf("order of primitive %d-th root = %d", n, got)
+		}
+	}
+
+	// ω^k has order n/gcd(n, k); compare with a brute-force search for n = 60
+	root, _ := GeneratePrimitiveRoot(60)
+	for k := uint64(0); k < 60; k++ {
+		e := root.ModPow(k)
+		want := uint64(1)
+		for x := e; !x.IsOne(); x = x.Mul(e) {
+			want++
+		}
+		if got := e.MultiplicativeOrder(); got != want {
+			t.Errorf("order of ω^%d = %d, want %d", k, got, want)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package field
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/internal/parallel"
+)
+
+// SumChecked returns the sum of all elements.
+//
+// Accumulation strategy: the Montgomery representation is linear, so the raw
+// values can be added directly. They are accumulated into a 128-bit (hi, lo)
+// pair without any intermediate reduction. Each addend is below 2^64, so every
+// addition increases hi by at most one; hi is therefore bounded by len(elements)
+// and the accumulator cannot wrap for any slice that fits in memory. A single
+// reduce128 at the end canonicalizes the result.
+func SumChecked(elements []Element) Element {
+	var lo, hi uint64
+	for _, e := range elements {
+		var carry uint64
+		lo, carry = bits.Add64(lo, e.value, 0)
+		hi += carry
+	}
+	return Element{value: reduce128(uint128{lo: lo, hi: hi})}
+}
+
+// Sum returns the sum of all elements. It is SumChecked: the raw values are
+// accumulated without intermediate reduction and reduced once.
+func Sum(elements []Element) Element {
+	return SumChecked(elements)
+}
+
+// Product returns the product of all elements, or One for an empty slice. It
+// keeps four independent running products and combines them at the end, so
+// consecutive multiplications do not wait on each other's results.
+func Product(elements []Element) Element {
+	p0, p1, p2, p3 := One, One, One, One
+	n := len(elements) &^ 3
+	for i := 0; i < n; i += 4 {
+		p0 = p0.Mul(elements[i])
+		p1 = p1.Mul(elements[i+1])
+		p2 = p2.Mul(elements[i+2])
+		p3 = p3.Mul(elements[i+3])
+	}
+	for _, e := range elements[n:] {
+		p0 = p0.Mul(e)
+	}
+	return p0.Mul(p1).Mul(p2.Mul(p3))
+}
+
+// reduce128 returns x mod P as a canonical value, without Montgomery scaling.
+// It uses 2^64 ≡ 2^32 - 1 and 2^96 ≡ -1 (mod P).
+func reduce128(x uint128) uint64 {
+	hiHi := x.hi >> 32
+	hiLo := x.hi & epsilon
+
+	// hiHi·2^96 ≡ -hiHi (mod P): subtract it from lo, folding any borrow
+	t0, borrow := bits.Sub64(x.lo, hiHi, 0)
+	t0 -= borrow * epsilon
+
+	// hiLo·2^64 ≡ hiLo·epsilon, which is below P, so one carry fold suffices
+	t1 := hiLo * epsilon
+	t2, carry := bits.Add64(t0, t1, 0)
+	t2 += carry * epsilon
+
+	if t2 >= P {
+		t2 -= P
+	}
+	return t2
+}
+
+// PadToPowerOfTwo returns data extended with Zeros to the next power of two length.
+// If len(data) is already a power of two, data itself is returned; otherwise the
+// result is a new slice. An empty input yields []Element{Zero}, matching
+// ntt.NextPowerOfTwo(0) == 1.
+func PadToPowerOfTwo(data []Element) []Element {
+	n := len(data)
+	if n > 0 && n&(n-1) == 0 {
+		return data
+	}
+
+	size := 1
+	if n > 0 {
+		size = 1 << bits.Len(uint(n))
+	}
+
+	padded := make([]Element, size)
+	copy(padded, data)
+	return padded
+}
+
+// TrimTrailingZeros returns the prefix of data without its trailing Zeros.
+// The result shares data's backing array; an all-zero input yields an empty slice.
+func TrimTrailingZeros(data []Element) []Element {
+	end := len(data)
+	for end > 0 && data[end-1].IsZero() {
+		end--
+	}
+	return data[:end]
+}
+
+// SortSlice sorts data in place in ascending order of canonical value.
+// Elements are comparable, so slices.Compact removes duplicates afterwards.
+func SortSlice(data []Element) {
+	slices.SortFunc(data, Element.Cmp)
+}
+
+// PowElementwise returns bases[i]^exps[i] for every i. Large inputs are split
+// across goroutines.
+// Returns an error if the slices differ in length.
+func PowElementwise(bases []Element, exps []uint64) ([]Element, error) {
+	if len(bases) != len(exps) {
+		return nil, fmt.Errorf("got %d bases for %d exponents", len(bases), len(exps))
+	}
+
+	result := make([]Element, len(bases))
+	parallel.Chunks(len(bases), func(start, end int) {
+		for i := start; i < end; i++ {
+			result[i] = bases[i].ModPow(exps[i])
+		}
+	})
+	return result, nil
+}
+
+// The bulk operations below write into a caller-provided dst, which may alias
+// an input. Lengths are checked once up front and the inputs are re-sliced to
+// len(dst), so the compiler drops the per-element bounds checks.
+
+// AddSlices stores a[i] + b[i] in dst[i] for every i.
+// Returns an error if the slices differ in length.
+func AddSlices(dst, a, b []Element) error {
+	if err := checkSliceLengths(dst, a, b); err != nil {
+		return err
+	}
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i].Add(b[i])
+	}
+	return nil
+}
+
+// SubSlices stores a[i] - b[i] in dst[i] for every i.
+// Returns an error if the slices differ in length.
+func SubSlices(dst, a, b []Element) error {
+	if err := checkSliceLengths(dst, a, b); err != nil {
+		return err
+	}
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i].Sub(b[i])
+	}
+	return nil
+}
+
+// MulSlices stores a[i] · b[i] in dst[i] for every i.
+// Returns an error if the slices differ in length.
+func MulSlices(dst, a, b []Element) error {
+	if err := checkSliceLengths(dst, a, b); err != nil {
+		return err
+	}
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i].Mul(b[i])
+	}
+	return nil
+}
+
+// ScaleSlice stores scalar · a[i] in dst[i] for every i.
+// Returns an error if the slices differ in length.
+func ScaleSlice(dst, a []Element, scalar Element) error {
+	if len(a) != len(dst) {
+		return fmt.Errorf("slice lengths differ: dst %d, a %d", len(dst), len(a))
+	}
+	a = a[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i].Mul(scalar)
+	}
+	return nil
+}
+
+func checkSliceLengths(dst, a, b []Element) error {
+	if len(a) != len(dst) || len(b) != len(dst) {
+		return fmt.Errorf("slice lengths differ: dst %d, a %d, b %d", len(dst), len(a), len(b))
+	}
+	return nil
+}
+
+// Dot returns the inner product Σ a[i]·b[i]. The products are summed unreduced
+// through an Accumulator, so the whole sum costs one reduction. See
+// BenchmarkDot4096.
+//
+// Panics if the slices differ in length.
+func Dot(a, b []Element) Element {
+	return Accumulator{}.MulAddSlices(a, b).Reduce()
+}
@@ -0,0 +1,392 @@
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/internal/parallel"
+)
+
+func bigSum(elements []Element) Element {
+	sum := new(big.Int)
+	v := new(big.Int)
+	for _, e := range elements {
+		sum.Add(sum, v.SetUint64(e.Value()))
+	}
+	return NewFromBigInt(sum)
+}
+
+func TestSumChecked(t *testing.T) {
+	tests := []struct {
+		name     string
+		elements []Element
+		want     Element
+	}{
+		{"empty", nil, Zero},
+		{"single", []Element{New(42)}, New(42)},
+		{"small", []Element{New(1), New(2), New(3)}, New(6)},
+		{"wraps once", []Element{Max, New(2)}, One},
+		{"cancels", []Element{New(5), New(5).Neg()}, Zero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SumChecked(tt.elements); !got.Equal(tt.want) {
+				t.Errorf("SumChecked = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSumAndProduct(t *testing.T) {
+	rng := rand.New(rand.NewSource(528))
+	// Lengths around the four-way split of Product
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 1001} {
+		elements := make([]Element, n)
+		sum, product := Zero, One
+		for i := range elements {
+			elements[i] = New(rng.Uint64())
+			sum = sum.Add(elements[i])
+			product = product.Mul(elements[i])
+		}
+		if got := Sum(elements); !got.Equal(sum) {
+			t.Errorf("n=%d: Sum = %v, want %v", n, got, sum)
+		}
+		if got := Product(elements); !got.Equal(product) {
+			t.Errorf("n=%d: Product = %v, want %v", n, got, product)
+		}
+	}
+
+	if !Product([]Element{New(3), Zero, New(5), New(7), New(9)}).IsZero() {
+		t.Error("Product with a zero factor should be zero")
+	}
+}
+
+func BenchmarkProduct4096(b *testing.B) {
+	rng := rand.New(rand.NewSource(528))
+	x := make([]Element, 4096)
+	for i := range x {
+		x[i] = New(rng.Uint64())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Product(x)
+	}
+}
+
+func TestReduce128(t *testing.T) {
+	values := []uint128{
+		{lo: 0, hi: 0},
+		{lo: P - 1, hi: 0},
+		{lo: P, hi: 0},
+		{lo: ^uint64(0), hi: 0},
+		{lo: 0, hi: 1},
+		{lo: ^uint64(0), hi: ^uint64(0)},
+		{lo: 0, hi: 1 << 32},
+		{lo: 12345, hi: P - 1},
+	}
+
+	mod := new(big.Int).SetUint64(P)
+	for _, x := range values {
+		want := new(big.Int).Lsh(new(big.Int).SetUint64(x.hi), 64)
+		want.Add(want, new(big.Int).SetUint64(x.lo))
+		want.Mod(want, mod)
+
+		if got := reduce128(x); got != want.Uint64() {
+			t.Errorf("reduce128(%d·2^64 + %d) = %d, want %d", x.hi, x.lo, got, want.Uint64())
+		}
+	}
+}
+
+// TestSumCheckedStress guards the wide accumulator against overflow on long
+// sums of maximal elements.
+func TestSumCheckedStress(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping 10M element stress test in short mode")
+	}
+
+	const n = 10_000_000
+	elements := make([]Element, n)
+	for i := range elements {
+		elements[i] = New(P - 1 - uint64(i%1000))
+	}
+
+	if got, want := SumChecked(elements), bigSum(elements); !got.Equal(want) {
+		t.Errorf("SumChecked = %v, want %v", got, want)
+	}
+}
+
+func TestPadToPowerOfTwo(t *testing.T) {
+	tests := []struct {
+		length  int
+		wantLen int
+	}{
+		{0, 1},
+		{1, 1},
+		{2, 2},
+		{3, 4},
+		{4, 4},
+		{5, 8},
+		{8, 8},
+		{9, 16},
+		{1024, 1024},
+		{1025, 2048},
+	}
+
+	for _, tt := range tests {
+		data := make([]Element, tt.length)
+		for i := range data {
+			data[i] = New(uint64(i + 1))
+		}
+
+		padded := PadToPowerOfTwo(data)
+		if len(padded) != tt.wantLen {
+			t.Errorf("PadToPowerOfTwo(len %d) has length %d, want %d", tt.length, len(padded), tt.wantLen)
+			continue
+		}
+		for i := range padded {
+			want := Zero
+			if i < tt.length {
+				want = data[i]
+			}
+			if !padded[i].Equal(want) {
+				t.Errorf("len %d: padded[%d] = %v, want %v", tt.length, i, padded[i], want)
+			}
+		}
+	}
+}
+
+func TestPadToPowerOfTwoDoesNotAlias(t *testing.T) {
+	backing := make([]Element, 3, 8)
+	padded := PadToPowerOfTwo(backing)
+	padded[3] = One
+	if backing[:4][3].IsOne() {
+		t.Error("padding wrote into the input's spare capacity")
+	}
+}
+
+func TestTrimTrailingZeros(t *testing.T) {
+	tests := []struct {
+		input   []Element
+		wantLen int
+	}{
+		{nil, 0},
+		{[]Element{Zero, Zero}, 0},
+		{[]Element{One}, 1},
+		{[]Element{One, Zero, Zero}, 1},
+		{[]Element{Zero, One, Zero}, 2},
+		{[]Element{One, New(2), New(3)}, 3},
+	}
+
+	for _, tt := range tests {
+		if got := TrimTrailingZeros(tt.input); len(got) != tt.wantLen {
+			t.Errorf("TrimTrailingZeros(%v) has length %d, want %d", tt.input, len(got), tt.wantLen)
+		}
+	}
+}
+
+func TestPadThenTrimRoundTrip(t *testing.T) {
+	// Five values, the last two of which are zero: trimming removes them and the padding
+	data := []Element{New(4), Zero, New(9), Zero, Zero}
+	trimmed := TrimTrailingZeros(PadToPowerOfTwo(data))
+
+	want := []Element{New(4), Zero, New(9)}
+	if len(trimmed) != len(want) {
+		t.Fatalf("round trip has length %d, want %d", len(trimmed), len(want))
+	}
+	for i := range want {
+		if !trimmed[i].Equal(want[i]) {
+			t.Errorf("round trip[%d] = %v, want %v", i, trimmed[i], want[i])
+		}
+	}
+}
+
+func TestSortSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(527))
+	data := []Element{Max, Zero, One, New(1 << 32), Max, New(2)}
+	for i := 0; i < 100; i++ {
+		data = append(data, New(rng.Uint64()%1000))
+	}
+
+	SortSlice(data)
+	for i := 1; i < len(data); i++ {
+		if data[i-1].Value() > data[i].Value() {
+			t.Fatalf("not sorted at %d: %v > %v", i, data[i-1], data[i])
+		}
+	}
+	if !data[0].IsZero() || !data[len(data)-1].Equal(Max) {
+		t.Errorf("sorted range is [%v, %v], want [0, P-1]", data[0], data[len(data)-1])
+	}
+
+	unique := slices.Compact(data)
+	for i := 1; i < len(unique); i++ {
+		if unique[i-1].Cmp(unique[i]) != -1 {
+			t.Fatalf("duplicate or unordered at %d after Compact", i)
+		}
+	}
+}
+
+func TestPowElementwise(t *testing.T) {
+	rng := rand.New(rand.NewSource(414))
+
+	// Force the goroutine path even on single-CPU machines
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	// Sizes on both sides of the parallel threshold
+	for _, n := range []int{0, 1, 100, parallel.Threshold + 17} {
+		bases := make([]Element, n)
+		exps := make([]uint64, n)
+		for i := range bases {
+			bases[i] = New(rng.Uint64())
+			exps[i] = rng.Uint64()
+		}
+		if n > 2 {
+			exps[0], exps[1] = 0, 1
+		}
+
+		got, err := PowElementwise(bases, exps)
+		if err != nil {
+			t.Fatalf("PowElementwise failed: %v", err)
+		}
+		if len(got) != n {
+			t.Fatalf("got %d results, want %d", len(got), n)
+		}
+		for i := range bases {
+			if want := bases[i].ModPow(exps[i]); !got[i].Equal(want) {
+				t.Fatalf("n=%d: result[%d] = %v, want %v", n, i, got[i], want)
+			}
+		}
+	}
+}
+
+func TestPowElementwiseLengthMismatch(t *testing.T) {
+	if _, err := PowElementwise(make([]Element, 3), make([]uint64, 2)); err == nil {
+		t.Error("expected error for length mismatch")
+	}
+}
+
+func benchmarkPowInputs() ([]Element, []uint64) {
+	rng := rand.New(rand.NewSource(414))
+	bases := make([]Element, 1<<16)
+	exps := make([]uint64, 1<<16)
+	for i := range bases {
+		bases[i] = New(rng.Uint64())
+		exps[i] = rng.Uint64()
+	}
+	return bases, exps
+}
+
+func BenchmarkPowElementwise65536(b *testing.B) {
+	bases, exps := benchmarkPowInputs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = PowElementwise(bases, exps)
+	}
+}
+
+func BenchmarkPowScalarLoop65536(b *testing.B) {
+	bases, exps := benchmarkPowInputs()
+	result := make([]Element, len(bases))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range bases {
+			result[j] = bases[j].ModPow(exps[j])
+		}
+	}
+}
+
+func TestSliceArithmetic(t *testing.T) {
+	rng := rand.New(rand.NewSource(513))
+	for _, n := range []int{0, 1, 7, 100} {
+		a := make([]Element, n)
+		b := make([]Element, n)
+		for i := range a {
+			a[i] = New(rng.Uint64())
+			b[i] = New(rng.Uint64())
+		}
+		scalar := New(rng.Uint64())
+
+		sum := make([]Element, n)
+		diff := make([]Element, n)
+		prod := make([]Element, n)
+		scaled := make([]Element, n)
+		if AddSlices(sum, a, b) != nil || SubSlices(diff, a, b) != nil ||
+			MulSlices(prod, a, b) != nil || ScaleSlice(scaled, a, scalar) != nil {
+			t.Fatalf("n=%d: slice operation failed on equal lengths", n)
+		}
+		for i := range a {
+			if !sum[i].Equal(a[i].Add(b[i])) || !diff[i].Equal(a[i].Sub(b[i])) ||
+				!prod[i].Equal(a[i].Mul(b[i])) || !scaled[i].Equal(a[i].Mul(scalar)) {
+				t.Fatalf("n=%d: mismatch at index %d", n, i)
+			}
+		}
+
+		// dst aliasing an input
+		inPlace := append([]Element(nil), a...)
+		if err := MulSlices(inPlace, inPlace, b); err != nil {
+			t.Fatal(err)
+		}
+		for i := range inPlace {
+			if !inPlace[i].Equal(prod[i]) {
+				t.Fatalf("n=%d: in-place MulSlices mismatch at %d", n, i)
+			}
+		}
+	}
+}
+
+func TestSliceArithmeticLengthMismatch(t *testing.T) {
+	short, long := make([]Element, 2), make([]Element, 3)
+	if AddSlices(long, long, short) == nil || SubSlices(short, long, long) == nil ||
+		MulSlices(long, short, long) == nil || ScaleSlice(short, long, One) == nil {
+		t.Error("mismatched lengths should fail")
+	}
+}
+
+func BenchmarkMulSlices16384(b *testing.B) {
+	rng := rand.New(rand.NewSource(513))
+	x, y, dst := make([]Element, 1<<14), make([]Element, 1<<14), make([]Element, 1<<14)
+	for i := range x {
+		x[i], y[i] = New(rng.Uint64()), New(rng.Uint64())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = MulSlices(dst, x, y)
+	}
+}
+
+func TestDot(t *testing.T) {
+	rng := rand.New(rand.NewSource(522))
+	for _, n := range []int{0, 1, 3, 1000} {
+		a, b := make([]Element, n), make([]Element, n)
+		want := Zero
+		for i := range a {
+			a[i], b[i] = New(rng.Uint64()), New(rng.Uint64())
+			want = want.Add(a[i].Mul(b[i]))
+		}
+		if got := Dot(a, b); !got.Equal(want) {
+			t.Errorf("n=%d: Dot = %v, want %v", n, got, want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for mismatched lengths")
+		}
+	}()
+	Dot(make([]Element, 2), make([]Element, 1))
+}
+
+func BenchmarkDot4096(b *testing.B) {
+	rng := rand.New(rand.NewSource(522))
+	x, y := make([]Element, 4096), make([]Element, 4096)
+	for i := range x {
+		x[i], y[i] = New(rng.Uint64()), New(rng.Uint64())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Dot(x, y)
+	}
+}
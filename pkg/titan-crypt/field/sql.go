@@ -0,0 +1,53 @@
+package field
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// Scan implements sql.Scanner. It accepts the decimal text written by
+// SQLElement (as a string or as the []byte most drivers return for TEXT and
+// NUMERIC columns) and non-negative int64 values from integer columns. Values
+// must be canonical; NULL is rejected, as for other non-nullable types. On
+// error e is left unchanged.
+func (e *Element) Scan(src any) error {
+	switch v := src.(type) {
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("cannot scan negative integer %d into a field element", v)
+		}
+		*e = New(uint64(v))
+		return nil
+	case string:
+		return e.UnmarshalText([]byte(v))
+	case []byte:
+		return e.UnmarshalText(v)
+	case nil:
+		return fmt.Errorf("cannot scan NULL into a field element")
+	default:
+		return fmt.Errorf("cannot scan %T into a field element", src)
+	}
+}
+
+// SQLElement wraps an Element for use as a query argument. Element cannot
+// implement driver.Valuer itself because its Value method returns the
+// canonical uint64. The element is stored as its canonical value in decimal,
+// which fits NUMERIC(20) and TEXT columns; BIGINT is signed and cannot hold
+// values of 2^63 and above.
+//
+//	db.Exec("INSERT INTO openings (value) VALUES ($1)", field.SQLElement{e})
+//	db.QueryRow("SELECT value FROM openings").Scan(&e)
+type SQLElement struct {
+	Element
+}
+
+// Value implements driver.Valuer.
+func (s SQLElement) Value() (driver.Value, error) {
+	return strconv.FormatUint(s.Element.Value(), 10), nil
+}
+
+// Scan implements sql.Scanner using Element.Scan.
+func (s *SQLElement) Scan(src any) error {
+	return s.Element.Scan(src)
+}
@@ -0,0 +1,53 @@
+package field
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*Element)(nil)
+	_ sql.Scanner   = (*SQLElement)(nil)
+	_ driver.Valuer = SQLElement{}
+)
+
+func TestSQLElementRoundTrip(t *testing.T) {
+	for _, e := range []Element{Zero, One, Max, New(1 << 63), New(123456789)} {
+		v, err := SQLElement{e}.Value()
+		if err != nil {
+			t.Fatalf("Value failed: %v", err)
+		}
+		if !driver.IsValue(v) {
+			t.Fatalf("Value returned %T, not a driver.Value", v)
+		}
+
+		// Drivers hand text back as either string or []byte
+		for _, src := range []any{v, []byte(v.(string))} {
+			var got SQLElement
+			if err := got.Scan(src); err != nil {
+				t.Fatalf("Scan(%v) failed: %v", src, err)
+			}
+			if !got.Equal(e) {
+				t.Errorf("round trip of %v = %v", e, got.Element)
+			}
+		}
+	}
+}
+
+func TestElementScan(t *testing.T) {
+	var e Element
+	if err := e.Scan(int64(42)); err != nil || !e.Equal(New(42)) {
+		t.Errorf("Scan(int64(42)) = %v, %v", e, err)
+	}
+
+	e = One
+	for _, src := range []any{int64(-1), "18446744069414584321", []byte("x"), nil, 3.5} {
+		if err := e.Scan(src); err == nil {
+			t.Errorf("Scan(%v) should fail", src)
+		}
+	}
+	if !e.IsOne() {
+		t.Error("failed Scan modified the receiver")
+	}
+}
@@ -0,0 +1,58 @@
+package field
+
+// Sqrt returns a square root of e and true, or (Zero, false) if e is not a square.
+// Zero is its own square root; the other root of a nonzero square is its negation.
+//
+// It runs Tonelli–Shanks with P - 1 = Q·2^32, Q = 2^32 - 1: the primitive root of
+// unity of order 2^32 from PrimitiveRoots generates the 2-Sylow subgroup, so no
+// non-residue search is needed, and e^Q, e^((Q+1)/2) = e^(2^31) use fixed chains.
+// Running time depends on e.
+func (e Element) Sqrt() (Element, bool) {
+	if e.IsZero() {
+		return Zero, true
+	}
+
+	m := MaxTwoAdicity
+	c := PrimitiveRootOfUnity(1 << MaxTwoAdicity)
+	t := powOddPart(e)
+	root := squareN(e, 31)
+
+	// Invariant: root² = e·t, and t has order dividing 2^(m-1) once e is a square.
+	for !t.IsOne() {
+		// Least i with t^(2^i) = 1
+		i := 0
+		for s := t; !s.IsOne(); s = s.Square() {
+			i++
+			if i == m {
+				return Zero, false
+			}
+		}
+
+		b := squareN(c, m-i-1)
+		m = i
+		c = b.Square()
+		t = t.Mul(c)
+		root = root.Mul(b)
+	}
+
+	return root, true
+}
+
+// Legendre returns the Legendre symbol of e: 1 if e is a nonzero square, -1 if it
+// is a non-square and 0 if it is zero. It is Euler's criterion, PowHalfOrder,
+// which costs one fixed chain instead of a full square root.
+func (e Element) Legendre() int {
+	switch s := PowHalfOrder(e); {
+	case s.IsZero():
+		return 0
+	case s.IsOne():
+		return 1
+	default:
+		return -1
+	}
+}
+
+// IsSquare returns true if e has a square root. Zero is a square.
+func (e Element) IsSquare() bool {
+	return e.Legendre() >= 0
+}
@@ -0,0 +1,93 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSqrt(t *testing.T) {
+	rng := rand.New(rand.NewSource(505))
+
+	for i := 0; i < 200; i++ {
+		x := New(rng.Uint64())
+		square := x.Square()
+		root, ok := square.Sqrt()
+		if !ok {
+			t.Fatalf("Sqrt(%v²) reported a non-square", x)
+		}
+		if !root.Equal(x) && !root.Equal(x.Neg()) {
+			t.Fatalf("Sqrt(%v²) = %v, want ±%v", x, root, x)
+		}
+	}
+
+	for _, e := range []Element{Zero, One, Max, New(4), PrimitiveRootOfUnity(1 << 31)} {
+		root, ok := e.Sqrt()
+		if !ok || !root.Square().Equal(e) {
+			t.Errorf("Sqrt(%v) = %v, %v", e, root, ok)
+		}
+	}
+}
+
+func TestSqrtNonSquares(t *testing.T) {
+	rng := rand.New(rand.NewSource(505))
+
+	// The generator and the primitive 2^32-th root of unity are non-squares.
+	for _, e := range []Element{Generator(), PrimitiveRootOfUnity(1 << 32)} {
+		if root, ok := e.Sqrt(); ok {
+			t.Errorf("Sqrt(%v) = %v, want non-square", e, root)
+		}
+	}
+
+	for i := 0; i < 200; i++ {
+		e := New(rng.Uint64())
+		root, ok := e.Sqrt()
+		isSquare := PowHalfOrder(e).IsOne() || e.IsZero()
+		if ok != isSquare {
+			t.Fatalf("Sqrt(%v) ok = %v, Euler's criterion says %v", e, ok, isSquare)
+		}
+		if ok && !root.Square().Equal(e) {
+			t.Fatalf("Sqrt(%v)² = %v", e, root.Square())
+		}
+	}
+}
+
+func BenchmarkSqrt(b *testing.B) {
+	e := New(0x1234567890abcdef).Square()
+	for i := 0; i < b.N; i++ {
+		e.Sqrt()
+	}
+}
+
+func TestLegendre(t *testing.T) {
+	if got := Zero.Legendre(); got != 0 {
+		t.Errorf("Legendre(0) = %d, want 0", got)
+	}
+	if got := One.Legendre(); got != 1 {
+		t.Errorf("Legendre(1) = %d, want 1", got)
+	}
+	if got := Generator().Legendre(); got != -1 {
+		t.Errorf("Legendre(generator) = %d, want -1", got)
+	}
+	if !Zero.IsSquare() || Generator().IsSquare() {
+		t.Error("IsSquare misclassifies zero or the generator")
+	}
+
+	rng := rand.New(rand.NewSource(506))
+	for i := 0; i < 200; i++ {
+		x := New(rng.Uint64())
+		if x.IsZero() {
+			continue
+		}
+		if x.Square().Legendre() != 1 {
+			t.Fatalf("Legendre(%v²) != 1", x)
+		}
+		// Multiplying by a non-square flips the symbol.
+		if got, want := x.Mul(Generator()).Legendre(), -x.Legendre(); got != want {
+			t.Fatalf("Legendre(7·%v) = %d, want %d", x, got, want)
+		}
+		_, ok := x.Sqrt()
+		if x.IsSquare() != ok {
+			t.Fatalf("IsSquare(%v) = %v but Sqrt ok = %v", x, x.IsSquare(), ok)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package field
+
+import (
+	"fmt"
+)
+
+// Vector is an indexable sequence of elements that need not live in a Go slice.
+// The elementwise operations below accept any Vector, so data larger than RAM can
+// be processed through a file-backed implementation.
+//
+// An mmap-backed Vector maps a file of 8-byte little-endian words (for example with
+// syscall.Mmap) and stores each element's RawValue there: At(i) returns
+// NewFromRaw(binary.LittleEndian.Uint64(buf[8*i:])) and Set(i, e) writes
+// e.RawValue() back with binary.LittleEndian.PutUint64. Storing raw Montgomery
+// values avoids a conversion per access; use NewFromRawChecked if the file is
+// untrusted.
+type Vector interface {
+	// Len returns the number of elements.
+	Len() int
+	// At returns the element at index i.
+	At(i int) Element
+	// Set stores e at index i.
+	Set(i int, e Element)
+}
+
+// SliceVector is the in-memory Vector backed by a []Element.
+type SliceVector []Element
+
+// Len returns the number of elements.
+func (v SliceVector) Len() int {
+	return len(v)
+}
+
+// At returns v[i].
+func (v SliceVector) At(i int) Element {
+	return v[i]
+}
+
+// Set stores e at v[i].
+func (v SliceVector) Set(i int, e Element) {
+	v[i] = e
+}
+
+// AddVec stores a[i] + b[i] in dst[i] for every i. dst may be a or b. When all
+// three are SliceVectors this is AddSlices; other Vectors go through At and Set.
+// Returns an error if the vectors differ in length.
+func AddVec(dst, a, b Vector) error {
+	if err := checkVecLengths(dst, a, b); err != nil {
+		return err
+	}
+	if d, x, y, ok := asSlices(dst, a, b); ok {
+		return AddSlices(d, x, y)
+	}
+	for i := 0; i < a.Len(); i++ {
+		dst.Set(i, a.At(i).Add(b.At(i)))
+	}
+	return nil
+}
+
+// MulVec stores a[i] · b[i] in dst[i] for every i. dst may be a or b. When all
+// three are SliceVectors this is MulSlices; other Vectors go through At and Set.
+// Returns an error if the vectors differ in length.
+func MulVec(dst, a, b Vector) error {
+	if err := checkVecLengths(dst, a, b); err != nil {
+		return err
+	}
+	if d, x, y, ok := asSlices(dst, a, b); ok {
+		return MulSlices(d, x, y)
+	}
+	for i := 0; i < a.Len(); i++ {
+		dst.Set(i, a.At(i).Mul(b.At(i)))
+	}
+	return nil
+}
+
+// asSlices returns the slices behind dst, a and b if all three are SliceVectors.
+func asSlices(dst, a, b Vector) (d, x, y []Element, ok bool) {
+	sd, ok1 := dst.(SliceVector)
+	sa, ok2 := a.(SliceVector)
+	sb, ok3 := b.(SliceVector)
+	return sd, sa, sb, ok1 && ok2 && ok3
+}
+
+func checkVecLengths(dst, a, b Vector) error {
+	if a.Len() != b.Len() || dst.Len() != a.Len() {
+		return fmt.Errorf("vector lengths differ: dst %d, a %d, b %d", dst.Len(), a.Len(), b.Len())
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package field
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSliceVectorOps(t *testing.T) {
+	rng := rand.New(rand.NewSource(420))
+	const n = 100
+
+	a := make([]Element, n)
+	b := make([]Element, n)
+	for i := range a {
+		a[i] = New(rng.Uint64())
+		b[i] = New(rng.Uint64())
+	}
+
+	sum := make(SliceVector, n)
+	prod := make(SliceVector, n)
+	if err := AddVec(sum, SliceVector(a), SliceVector(b)); err != nil {
+		t.Fatalf("AddVec failed: %v", err)
+	}
+	if err := MulVec(prod, SliceVector(a), SliceVector(b)); err != nil {
+		t.Fatalf("MulVec failed: %v", err)
+	}
+	for i := range a {
+		if !sum.At(i).Equal(a[i].Add(b[i])) {
+			t.Fatalf("AddVec[%d] = %v, want %v", i, sum[i], a[i].Add(b[i]))
+		}
+		if !prod.At(i).Equal(a[i].Mul(b[i])) {
+			t.Fatalf("MulVec[%d] = %v, want %v", i, prod[i], a[i].Mul(b[i]))
+		}
+	}
+
+	// In place: dst aliases a
+	inPlace := append(SliceVector(nil), a...)
+	if err := MulVec(inPlace, inPlace, SliceVector(b)); err != nil {
+		t.Fatalf("in-place MulVec failed: %v", err)
+	}
+	for i := range a {
+		if !inPlace[i].Equal(prod[i]) {
+			t.Fatalf("in-place MulVec[%d] = %v, want %v", i, inPlace[i], prod[i])
+		}
+	}
+}
+
+func TestVectorLengthMismatch(t *testing.T) {
+	short := make(SliceVector, 3)
+	long := make(SliceVector, 4)
+	if err := AddVec(long, long, short); err == nil {
+		t.Error("AddVec with mismatched inputs should fail")
+	}
+	if err := MulVec(short, long, long); err == nil {
+		t.Error("MulVec with mismatched dst should fail")
+	}
+	if err := AddVec(SliceVector{}, SliceVector{}, SliceVector{}); err != nil {
+		t.Errorf("AddVec on empty vectors failed: %v", err)
+	}
+}
@@ -0,0 +1,26 @@
+package field
+
+// XGCD returns g = gcd(a, b) together with Bézout coefficients x and y such
+// that a·x + b·y = g over the integers. It runs the extended Euclidean
+// algorithm on the plain uint64 values, not on field elements; Inverse does not
+// use it (see InverseViaGCD for the binary variant over the field).
+//
+// For a, b > 0 the coefficients satisfy |x| ≤ b/(2g) and |y| ≤ a/(2g) except
+// in the divisor cases, where one of them is 0 and the other 1, so they always
+// fit in an int64. XGCD(a, 0) returns (a, 1, 0) and XGCD(0, b) returns (b, 0, 1).
+func XGCD(a, b uint64) (g uint64, x, y int64) {
+	r0, r1 := a, b
+	s0, s1 := int64(1), int64(0)
+	t0, t1 := int64(0), int64(1)
+
+	for r1 != 0 {
+		q := r0 / r1
+		r0, r1 = r1, r0-q*r1
+
+		// The coefficients of the final step may leave the int64 range, but they
+		// are discarded; wrapping arithmetic keeps every retained value exact
+		s0, s1 = s1, s0-int64(q)*s1
+		t0, t1 = t1, t0-int64(q)*t1
+	}
+	return r0, s0, t0
+}
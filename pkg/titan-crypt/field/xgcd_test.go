@@ -0,0 +1,71 @@
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// checkBezout verifies a·x + b·y = g and g = gcd(a, b) with big integers.
+func checkBezout(t *testing.T, a, b uint64) {
+	t.Helper()
+	g, x, y := XGCD(a, b)
+
+	bigA, bigB := new(big.Int).SetUint64(a), new(big.Int).SetUint64(b)
+	if want := new(big.Int).GCD(nil, nil, bigA, bigB); want.Cmp(new(big.Int).SetUint64(g)) != 0 {
+		t.Fatalf("XGCD(%d, %d) gcd = %d, want %s", a, b, g, want)
+	}
+
+	lhs := new(big.Int).Mul(bigA, big.NewInt(x))
+	lhs.Add(lhs, new(big.Int).Mul(bigB, big.NewInt(y)))
+	if lhs.Cmp(new(big.Int).SetUint64(g)) != 0 {
+		t.Fatalf("XGCD(%d, %d) = (%d, %d, %d), but a·x + b·y = %s", a, b, g, x, y, lhs)
+	}
+}
+
+func TestXGCD(t *testing.T) {
+	const max = ^uint64(0)
+	edges := [][2]uint64{
+		{0, 0}, {0, 5}, {5, 0}, {1, 1}, {7, 7},
+		{max, 1}, {1, max}, {max, max}, {max, max - 1}, {max - 1, max},
+		{P, P - 1}, {P - 1, P}, {P, 1 << 32}, {1 << 63, 1 << 62}, {max, 1 << 63},
+		// Consecutive Fibonacci numbers take the most steps
+		{12200160415121876738, 7540113804746346429},
+	}
+	for _, e := range edges {
+		checkBezout(t, e[0], e[1])
+	}
+
+	rng := rand.New(rand.NewSource(526))
+	for i := 0; i < 2000; i++ {
+		a, b := rng.Uint64(), rng.Uint64()
+		// Give some pairs a large common factor
+		if i%4 == 0 {
+			f := rng.Uint64()>>40 + 1
+			a, b = (a>>40)*f, (b>>40)*f
+		}
+		checkBezout(t, a, b)
+	}
+}
+
+func TestXGCDInvertsModP(t *testing.T) {
+	rng := rand.New(rand.NewSource(526))
+	for i := 0; i < 100; i++ {
+		e := New(rng.Uint64())
+		if e.IsZero() {
+			continue
+		}
+		// e·x + P·y = 1, so x ≡ e^-1 (mod P)
+		g, x, _ := XGCD(e.Value(), P)
+		if g != 1 {
+			t.Fatalf("gcd(%v, P) = %d", e, g)
+		}
+		inv := New(uint64(x))
+		if x < 0 {
+			inv = New(uint64(-x)).Neg()
+		}
+		if !inv.Equal(e.Inverse()) {
+			t.Fatalf("XGCD inverse of %v = %v, want %v", e, inv, e.Inverse())
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package hash
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// HashElements commits to a vector of field elements with Tip5's variable-length
+// hash. It is HashVarlen returned as a Digest: deterministic, order-sensitive and
+// length-sensitive, but without the openings a Merkle tree provides.
+func HashElements(elems []field.Element) Digest {
+	return Digest(HashVarlen(elems))
+}
+
+// DigestElements commits to a vector of field elements with SHA-256 over their
+// canonical encodings: each element's canonical value (not its Montgomery form)
+// as 8 little-endian bytes, concatenated in order.
+func DigestElements(elems []field.Element) [sha256.Size]byte {
+	h := sha256.New()
+	var buf [8]byte
+	for _, e := range elems {
+		binary.LittleEndian.PutUint64(buf[:], e.Value())
+		h.Write(buf[:])
+	}
+
+	var digest [sha256.Size]byte
+	h.Sum(digest[:0])
+	return digest
+}
@@ -0,0 +1,69 @@
+package hash
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func commitTestElements() []field.Element {
+	return []field.Element{field.New(1), field.New(2), field.New(3), field.Max, field.Zero}
+}
+
+func TestHashElementsDeterministic(t *testing.T) {
+	elems := commitTestElements()
+	a := HashElements(elems)
+	b := HashElements(append([]field.Element(nil), elems...))
+	if !a.Equal(b) {
+		t.Error("HashElements is not deterministic")
+	}
+	if !a.Equal(Digest(HashVarlen(elems))) {
+		t.Error("HashElements differs from HashVarlen")
+	}
+}
+
+func TestHashElementsOrderAndLengthSensitive(t *testing.T) {
+	elems := commitTestElements()
+	base := HashElements(elems)
+
+	swapped := append([]field.Element(nil), elems...)
+	swapped[0], swapped[1] = swapped[1], swapped[0]
+	if HashElements(swapped).Equal(base) {
+		t.Error("reordering the input did not change HashElements")
+	}
+
+	if HashElements(append(elems, field.Zero)).Equal(base) {
+		t.Error("appending a zero did not change HashElements")
+	}
+}
+
+func TestDigestElementsDeterministic(t *testing.T) {
+	elems := commitTestElements()
+	if DigestElements(elems) != DigestElements(append([]field.Element(nil), elems...)) {
+		t.Error("DigestElements is not deterministic")
+	}
+
+	// Known encoding: canonical little-endian values
+	want := sha256.Sum256([]byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0})
+	if got := DigestElements([]field.Element{field.New(1), field.New(2)}); got != want {
+		t.Errorf("DigestElements([1, 2]) = %x, want %x", got, want)
+	}
+
+	if got, want := DigestElements(nil), sha256.Sum256(nil); got != want {
+		t.Errorf("DigestElements(nil) = %x, want %x", got, want)
+	}
+}
+
+func TestDigestElementsOrderSensitive(t *testing.T) {
+	elems := commitTestElements()
+	base := DigestElements(elems)
+
+	reversed := make([]field.Element, len(elems))
+	for i, e := range elems {
+		reversed[len(elems)-1-i] = e
+	}
+	if DigestElements(reversed) == base {
+		t.Error("reordering the input did not change DigestElements")
+	}
+}
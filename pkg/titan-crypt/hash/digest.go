@@ -1,7 +1,9 @@
 package hash
 
 import (
+	"database/sql/driver"
 	"encoding/binary"
+	"encoding/gob"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -105,6 +107,77 @@ func DigestFromHex(s string) (Digest, error) {
 	return DigestFromBytes(byteArray), nil
 }
 
+func init() {
+	// Lets Digests travel in interface-typed gob values
+	gob.Register(Digest{})
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the 40 bytes of ToBytes.
+func (d Digest) MarshalBinary() ([]byte, error) {
+	bytes := d.ToBytes()
+	return bytes[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the form written by
+// MarshalBinary. Unlike DigestFromBytes, which reduces, it rejects elements that
+// are not canonical. Returns an error, leaving d unchanged, on any invalid input.
+func (d *Digest) UnmarshalBinary(data []byte) error {
+	if len(data) != DigestLen*8 {
+		return fmt.Errorf("invalid digest length: expected %d bytes, got %d", DigestLen*8, len(data))
+	}
+
+	var result Digest
+	for i := range result {
+		if err := result[i].SetBytes(data[i*8 : (i+1)*8]); err != nil {
+			return fmt.Errorf("invalid digest element %d: %w", i, err)
+		}
+	}
+	*d = result
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder with the 40-byte form of MarshalBinary.
+func (d Digest) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (d *Digest) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// Value implements driver.Valuer, storing the digest as the 80-character
+// string of Hex for TEXT columns.
+func (d Digest) Value() (driver.Value, error) {
+	return d.Hex(), nil
+}
+
+// Scan implements sql.Scanner. It accepts the hex text written by Value, as a
+// string or []byte, and the 40 raw bytes of MarshalBinary from BYTEA columns.
+// Elements must be canonical; NULL is rejected. On error d is left unchanged.
+func (d *Digest) Scan(src any) error {
+	var text string
+	switch v := src.(type) {
+	case string:
+		text = v
+	case []byte:
+		if len(v) == DigestLen*8 {
+			return d.UnmarshalBinary(v)
+		}
+		text = string(v)
+	case nil:
+		return fmt.Errorf("cannot scan NULL into a digest")
+	default:
+		return fmt.Errorf("cannot scan %T into a digest", src)
+	}
+
+	data, err := hex.DecodeString(text)
+	if err != nil {
+		return fmt.Errorf("invalid hex digest: %w", err)
+	}
+	return d.UnmarshalBinary(data)
+}
+
 // Less returns true if this digest is less than the other (for ordering).
 // Compares elements in reverse order (most significant first), matching twenty-first's Ord implementation.
 func (d Digest) Less(other Digest) bool {
@@ -0,0 +1,97 @@
+package hash
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/gob"
+	"strings"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestDigestGob(t *testing.T) {
+	type record struct {
+		Root   Digest
+		Leaves []Digest
+	}
+	in := record{
+		Root:   NewDigest([DigestLen]field.Element{field.New(1), field.New(2), field.New(3), field.Max, field.Zero}),
+		Leaves: []Digest{ZeroDigest(), Digest(HashPair(ZeroDigest(), ZeroDigest()))},
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	var out record
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+
+	if !out.Root.Equal(in.Root) || len(out.Leaves) != len(in.Leaves) {
+		t.Fatalf("round trip = %+v, want %+v", out, in)
+	}
+	for i := range in.Leaves {
+		if !out.Leaves[i].Equal(in.Leaves[i]) {
+			t.Errorf("Leaves[%d] = %v, want %v", i, out.Leaves[i], in.Leaves[i])
+		}
+	}
+}
+
+func TestDigestUnmarshalBinaryRejects(t *testing.T) {
+	d := ZeroDigest()
+	if err := d.UnmarshalBinary(make([]byte, DigestLen*8-1)); err == nil {
+		t.Error("expected error for short data")
+	}
+
+	data := make([]byte, DigestLen*8)
+	for i := 32; i < 40; i++ {
+		data[i] = 0xFF
+	}
+	if err := d.UnmarshalBinary(data); err == nil {
+		t.Error("expected error for non-canonical element")
+	}
+	if !d.IsZero() {
+		t.Error("failed UnmarshalBinary modified the receiver")
+	}
+}
+
+var (
+	_ sql.Scanner   = (*Digest)(nil)
+	_ driver.Valuer = Digest{}
+)
+
+func TestDigestSQL(t *testing.T) {
+	d := Digest(HashPair(ZeroDigest(), ZeroDigest()))
+	v, err := d.Value()
+	if err != nil {
+		t.Fatalf("Value failed: %v", err)
+	}
+	if !driver.IsValue(v) {
+		t.Fatalf("Value returned %T, not a driver.Value", v)
+	}
+
+	raw, _ := d.MarshalBinary()
+	for _, src := range []any{v, []byte(v.(string)), raw} {
+		var got Digest
+		if err := got.Scan(src); err != nil {
+			t.Fatalf("Scan(%T) failed: %v", src, err)
+		}
+		if !got.Equal(d) {
+			t.Errorf("Scan(%T) = %v, want %v", src, got, d)
+		}
+	}
+
+	got := ZeroDigest()
+	nonCanonical := strings.Repeat("ff", DigestLen*8)
+	for _, src := range []any{"zz", "abcd", nonCanonical, nil, int64(1)} {
+		if err := got.Scan(src); err == nil {
+			t.Errorf("Scan(%v) should fail", src)
+		}
+	}
+	if !got.IsZero() {
+		t.Error("failed Scan modified the receiver")
+	}
+}
@@ -0,0 +1,49 @@
+// Package parallel holds the goroutine fan-out shared by the element-wise
+// slice helpers of the field packages and by the parallel transforms and
+// batch evaluators built on them.
+package parallel
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Threshold is the slice length below which Chunks runs fn sequentially;
+// smaller inputs do not amortize the goroutine start-up cost.
+const Threshold = 4096
+
+// Chunks calls fn on contiguous [start, end) ranges covering [0, n), spread
+// over runtime.GOMAXPROCS(0) goroutines when n >= Threshold.
+func Chunks(n int, fn func(start, end int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if n < Threshold || workers <= 1 {
+		fn(0, n)
+		return
+	}
+
+	chunk := (n + workers - 1) / workers
+	Parts((n+chunk-1)/chunk, func(part int) {
+		start := part * chunk
+		fn(start, min(start+chunk, n))
+	})
+}
+
+// Parts calls fn(0), …, fn(parts-1), each on its own goroutine, and waits for
+// all of them. A single part runs on the calling goroutine; callers choose
+// parts to match the work they want to spread.
+func Parts(parts int, fn func(part int)) {
+	if parts == 1 {
+		fn(0)
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(parts)
+	for p := 0; p < parts; p++ {
+		go func(p int) {
+			defer wg.Done()
+			fn(p)
+		}(p)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,45 @@
+package parallel
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestChunksCoversRange(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	for _, n := range []int{0, 1, Threshold - 1, Threshold, 3*Threshold + 5} {
+		var mu sync.Mutex
+		hits := make([]int, n)
+		Chunks(n, func(start, end int) {
+			mu.Lock()
+			defer mu.Unlock()
+			for i := start; i < end; i++ {
+				hits[i]++
+			}
+		})
+		for i, h := range hits {
+			if h != 1 {
+				t.Fatalf("n=%d: index %d visited %d times", n, i, h)
+			}
+		}
+	}
+}
+
+func TestPartsRunsEachPartOnce(t *testing.T) {
+	for _, parts := range []int{0, 1, 2, 7} {
+		var mu sync.Mutex
+		hits := make([]int, parts)
+		Parts(parts, func(part int) {
+			mu.Lock()
+			defer mu.Unlock()
+			hits[part]++
+		})
+		for p, h := range hits {
+			if h != 1 {
+				t.Fatalf("parts=%d: part %d ran %d times", parts, p, h)
+			}
+		}
+	}
+}
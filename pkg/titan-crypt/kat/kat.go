@@ -0,0 +1,412 @@
+// Package kat exports and verifies known-answer test (KAT) vectors for the
+// base field and its cubic extension, so that other implementations can be
+// validated against this package.
+//
+// JSON Format:
+// All field elements are encoded as decimal strings of their canonical value,
+// so the document can be consumed by languages without 64-bit JSON integers.
+// Extension field elements are arrays [c₀, c₁, c₂] of such strings,
+// representing c₀ + c₁·x + c₂·x² modulo x³ - x + 1.
+//
+//	{
+//	  "version": 1,
+//	  "modulus": "18446744069414584321",
+//	  "field": {
+//	    "add":     [{"a": "...", "b": "...", "result": "..."}, ...],
+//	    "mul":     [{"a": "...", "b": "...", "result": "..."}, ...],
+//	    "inverse": [{"a": "...", "result": "..."}, ...],
+//	    "pow":     [{"a": "...", "exponent": "...", "result": "..."}, ...]
+//	  },
+//	  "primitive_roots": [{"order": "...", "root": "..."}, ...],
+//	  "xfield": {
+//	    "add":     [{"a": [...], "b": [...], "result": [...]}, ...],
+//	    "mul":     [{"a": [...], "b": [...], "result": [...]}, ...],
+//	    "inverse": [{"a": [...], "result": [...]}, ...]
+//	  }
+//	}
+//
+// The inputs are boundary values followed by a splitmix64 stream seeded with
+// Seed, so the exported document is byte-for-byte deterministic.
+package kat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/xfield"
+)
+
+const (
+	// Version is the version of the KAT document format
+	Version = 1
+
+	// Seed is the splitmix64 seed used to derive the pseudo-random inputs
+	Seed uint64 = 0x7469_7461_6e2d_6b61
+
+	// randomCases is the number of pseudo-random cases per operation
+	randomCases = 16
+)
+
+// Vectors is the top-level KAT document.
+type Vectors struct {
+	Version        int                 `json:"version"`
+	Modulus        string              `json:"modulus"`
+	Field          FieldVectors        `json:"field"`
+	PrimitiveRoots []PrimitiveRootCase `json:"primitive_roots"`
+	XField         XFieldVectors       `json:"xfield"`
+}
+
+// FieldVectors holds the base field cases.
+type FieldVectors struct {
+	Add     []BinaryCase `json:"add"`
+	Mul     []BinaryCase `json:"mul"`
+	Inverse []UnaryCase  `json:"inverse"`
+	Pow     []PowCase    `json:"pow"`
+}
+
+// BinaryCase is a base field operation with two operands.
+type BinaryCase struct {
+	A      string `json:"a"`
+	B      string `json:"b"`
+	Result string `json:"result"`
+}
+
+// UnaryCase is a base field operation with one operand.
+type UnaryCase struct {
+	A      string `json:"a"`
+	Result string `json:"result"`
+}
+
+// PowCase is a base field exponentiation a^exponent.
+type PowCase struct {
+	A        string `json:"a"`
+	Exponent string `json:"exponent"`
+	Result   string `json:"result"`
+}
+
+// PrimitiveRootCase is the primitive root of unity used for a given order.
+type PrimitiveRootCase struct {
+	Order string `json:"order"`
+	Root  string `json:"root"`
+}
+
+// XFieldVectors holds the extension field cases.
+type XFieldVectors struct {
+	Add     []XBinaryCase `json:"add"`
+	Mul     []XBinaryCase `json:"mul"`
+	Inverse []XUnaryCase  `json:"inverse"`
+}
+
+// XBinaryCase is an extension field operation with two operands.
+type XBinaryCase struct {
+	A      [xfield.ExtensionDegree]string `json:"a"`
+	B      [xfield.ExtensionDegree]string `json:"b"`
+	Result [xfield.ExtensionDegree]string `json:"result"`
+}
+
+// XUnaryCase is an extension field operation with one operand.
+type XUnaryCase struct {
+	A      [xfield.ExtensionDegree]string `json:"a"`
+	Result [xfield.ExtensionDegree]string `json:"result"`
+}
+
+// ExportKAT writes the known-answer vectors as indented JSON to w.
+func ExportKAT(w io.Writer) error {
+	return writeVectors(w, Generate())
+}
+
+// writeVectors encodes v in the canonical document layout.
+func writeVectors(w io.Writer, v *Vectors) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode KAT vectors: %w", err)
+	}
+	data = append(data, '\n')
+
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to write KAT vectors: %w", err)
+	}
+	return nil
+}
+
+// VerifyKAT reads a KAT document from r and checks every case against this
+// package's arithmetic. Returns an error describing the first mismatch.
+func VerifyKAT(r io.Reader) error {
+	var v Vectors
+	if err := json.NewDecoder(r).Decode(&v); err != nil {
+		return fmt.Errorf("failed to decode KAT vectors: %w", err)
+	}
+
+	if v.Version != Version {
+		return fmt.Errorf("unsupported KAT version %d, expected %d", v.Version, Version)
+	}
+	if v.Modulus != strconv.FormatUint(field.P, 10) {
+		return fmt.Errorf("modulus mismatch: got %s, expected %d", v.Modulus, field.P)
+	}
+
+	if err := verifyField(&v.Field); err != nil {
+		return err
+	}
+	if err := verifyPrimitiveRoots(v.PrimitiveRoots); err != nil {
+		return err
+	}
+	return verifyXField(&v.XField)
+}
+
+// Generate computes the KAT document.
+func Generate() *Vectors {
+	rng := splitmix64{state: Seed}
+	elements := inputElements(&rng)
+	xfes := inputXFieldElements(&rng)
+
+	v := &Vectors{
+		Version: Version,
+		Modulus: strconv.FormatUint(field.P, 10),
+	}
+
+	for i, a := range elements {
+		b := elements[(i+1)%len(elements)]
+		v.Field.Add = append(v.Field.Add, BinaryCase{encode(a), encode(b), encode(a.Add(b))})
+		v.Field.Mul = append(v.Field.Mul, BinaryCase{encode(a), encode(b), encode(a.Mul(b))})
+		if !a.IsZero() {
+			v.Field.Inverse = append(v.Field.Inverse, UnaryCase{encode(a), encode(a.Inverse())})
+		}
+	}
+
+	exponents := []uint64{0, 1, 2, 7, field.P - 2, field.P - 1, field.P}
+	for i := 0; i < randomCases; i++ {
+		exponents = append(exponents, rng.next())
+	}
+	for i, exp := range exponents {
+		a := elements[i%len(elements)]
+		v.Field.Pow = append(v.Field.Pow, PowCase{encode(a), strconv.FormatUint(exp, 10), encode(a.ModPow(exp))})
+	}
+
+	orders := make([]uint64, 0, len(field.PrimitiveRoots))
+	for order := range field.PrimitiveRoots {
+		if order != 0 {
+			orders = append(orders, order)
+		}
+	}
+	sort.Slice(orders, func(i, j int) bool { return orders[i] < orders[j] })
+	for _, order := range orders {
+		root, _ := field.GetPrimitiveRoot(order)
+		v.PrimitiveRoots = append(v.PrimitiveRoots, PrimitiveRootCase{strconv.FormatUint(order, 10), encode(root)})
+	}
+
+	for i, a := range xfes {
+		b := xfes[(i+1)%len(xfes)]
+		v.XField.Add = append(v.XField.Add, XBinaryCase{encodeX(a), encodeX(b), encodeX(a.Add(b))})
+		v.XField.Mul = append(v.XField.Mul, XBinaryCase{encodeX(a), encodeX(b), encodeX(a.Mul(b))})
+		if !a.IsZero() {
+			v.XField.Inverse = append(v.XField.Inverse, XUnaryCase{encodeX(a), encodeX(a.Inverse())})
+		}
+	}
+
+	return v
+}
+
+func verifyField(v *FieldVectors) error {
+	for i, c := range v.Add {
+		if err := checkBinary("field add", i, c, field.Element.Add); err != nil {
+			return err
+		}
+	}
+	for i, c := range v.Mul {
+		if err := checkBinary("field mul", i, c, field.Element.Mul); err != nil {
+			return err
+		}
+	}
+	for i, c := range v.Inverse {
+		a, err := decode(c.A)
+		if err != nil {
+			return fmt.Errorf("field inverse case %d: %w", i, err)
+		}
+		if a.IsZero() {
+			return fmt.Errorf("field inverse case %d: zero has no inverse", i)
+		}
+		if err := expect("field inverse", i, c.Result, a.Inverse()); err != nil {
+			return err
+		}
+	}
+	for i, c := range v.Pow {
+		a, err := decode(c.A)
+		if err != nil {
+			return fmt.Errorf("field pow case %d: %w", i, err)
+		}
+		exp, err := strconv.ParseUint(c.Exponent, 10, 64)
+		if err != nil {
+			return fmt.Errorf("field pow case %d: invalid exponent: %w", i, err)
+		}
+		if err := expect("field pow", i, c.Result, a.ModPow(exp)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyPrimitiveRoots(cases []PrimitiveRootCase) error {
+	for i, c := range cases {
+		order, err := strconv.ParseUint(c.Order, 10, 64)
+		if err != nil {
+			return fmt.Errorf("primitive root case %d: invalid order: %w", i, err)
+		}
+		root, err := field.GetPrimitiveRoot(order)
+		if err != nil {
+			return fmt.Errorf("primitive root case %d: %w", i, err)
+		}
+		if err := expect("primitive root", i, c.Root, root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyXField(v *XFieldVectors) error {
+	for i, c := range v.Add {
+		if err := checkXBinary("xfield add", i, c, xfield.XFieldElement.Add); err != nil {
+			return err
+		}
+	}
+	for i, c := range v.Mul {
+		if err := checkXBinary("xfield mul", i, c, xfield.XFieldElement.Mul); err != nil {
+			return err
+		}
+	}
+	for i, c := range v.Inverse {
+		a, err := decodeX(c.A)
+		if err != nil {
+			return fmt.Errorf("xfield inverse case %d: %w", i, err)
+		}
+		if a.IsZero() {
+			return fmt.Errorf("xfield inverse case %d: zero has no inverse", i)
+		}
+		if err := expectX("xfield inverse", i, c.Result, a.Inverse()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkBinary(name string, i int, c BinaryCase, op func(field.Element, field.Element) field.Element) error {
+	a, err := decode(c.A)
+	if err != nil {
+		return fmt.Errorf("%s case %d: %w", name, i, err)
+	}
+	b, err := decode(c.B)
+	if err != nil {
+		return fmt.Errorf("%s case %d: %w", name, i, err)
+	}
+	return expect(name, i, c.Result, op(a, b))
+}
+
+func checkXBinary(name string, i int, c XBinaryCase, op func(xfield.XFieldElement, xfield.XFieldElement) xfield.XFieldElement) error {
+	a, err := decodeX(c.A)
+	if err != nil {
+		return fmt.Errorf("%s case %d: %w", name, i, err)
+	}
+	b, err := decodeX(c.B)
+	if err != nil {
+		return fmt.Errorf("%s case %d: %w", name, i, err)
+	}
+	return expectX(name, i, c.Result, op(a, b))
+}
+
+func expect(name string, i int, want string, got field.Element) error {
+	if encode(got) != want {
+		return fmt.Errorf("%s case %d: expected %s, got %s", name, i, want, encode(got))
+	}
+	return nil
+}
+
+func expectX(name string, i int, want [xfield.ExtensionDegree]string, got xfield.XFieldElement) error {
+	if encodeX(got) != want {
+		return fmt.Errorf("%s case %d: expected %v, got %v", name, i, want, encodeX(got))
+	}
+	return nil
+}
+
+func encode(e field.Element) string {
+	return strconv.FormatUint(e.Value(), 10)
+}
+
+func encodeX(x xfield.XFieldElement) [xfield.ExtensionDegree]string {
+	return [xfield.ExtensionDegree]string{
+		encode(x.Coefficients[0]),
+		encode(x.Coefficients[1]),
+		encode(x.Coefficients[2]),
+	}
+}
+
+func decode(s string) (field.Element, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return field.Zero, fmt.Errorf("invalid element %q: %w", s, err)
+	}
+	if !field.IsCanonical(v) {
+		return field.Zero, fmt.Errorf("non-canonical element %s", s)
+	}
+	return field.New(v), nil
+}
+
+func decodeX(s [xfield.ExtensionDegree]string) (xfield.XFieldElement, error) {
+	var coeffs [xfield.ExtensionDegree]field.Element
+	for i := range s {
+		c, err := decode(s[i])
+		if err != nil {
+			return xfield.Zero, err
+		}
+		coeffs[i] = c
+	}
+	return xfield.New(coeffs), nil
+}
+
+// inputElements returns boundary values followed by pseudo-random elements.
+func inputElements(rng *splitmix64) []field.Element {
+	values := []uint64{0, 1, 2, 7, 1 << 32, (1 << 32) - 1, field.P - 2, field.P - 1}
+	for i := 0; i < randomCases; i++ {
+		values = append(values, rng.next()%field.P)
+	}
+
+	elements := make([]field.Element, len(values))
+	for i, v := range values {
+		elements[i] = field.New(v)
+	}
+	return elements
+}
+
+// inputXFieldElements returns structured values followed by pseudo-random extension elements.
+func inputXFieldElements(rng *splitmix64) []xfield.XFieldElement {
+	xfes := []xfield.XFieldElement{
+		xfield.Zero,
+		xfield.One,
+		xfield.New([3]field.Element{field.Zero, field.One, field.Zero}),
+		xfield.New([3]field.Element{field.Zero, field.Zero, field.One}),
+		xfield.New([3]field.Element{field.Max, field.Max, field.Max}),
+	}
+	for i := 0; i < randomCases; i++ {
+		xfes = append(xfes, xfield.New([3]field.Element{
+			field.New(rng.next() % field.P),
+			field.New(rng.next() % field.P),
+			field.New(rng.next() % field.P),
+		}))
+	}
+	return xfes
+}
+
+// splitmix64 is a tiny, portable PRNG used so the vectors can be regenerated in any language.
+type splitmix64 struct {
+	state uint64
+}
+
+func (s *splitmix64) next() uint64 {
+	s.state += 0x9E3779B97F4A7C15
+	z := s.state
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
@@ -0,0 +1,85 @@
+package kat
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// pinnedSHA256 is the SHA-256 of the exported KAT document.
+// If this changes, either the arithmetic or the document format changed; both
+// break compatibility with implementations validated against earlier vectors.
+const pinnedSHA256 = "6a613b05766f6449ac253b51f7ee398bc1cbfca95b3f75598abbaf5fc3fb59cd"
+
+func TestExportVerifyRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportKAT(&buf); err != nil {
+		t.Fatalf("ExportKAT failed: %v", err)
+	}
+
+	if err := VerifyKAT(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("VerifyKAT failed: %v", err)
+	}
+}
+
+func TestExportDeterministic(t *testing.T) {
+	var first, second bytes.Buffer
+	if err := ExportKAT(&first); err != nil {
+		t.Fatalf("ExportKAT failed: %v", err)
+	}
+	if err := ExportKAT(&second); err != nil {
+		t.Fatalf("ExportKAT failed: %v", err)
+	}
+
+	if !bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Error("ExportKAT is not deterministic")
+	}
+}
+
+func TestExportPinnedHash(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportKAT(&buf); err != nil {
+		t.Fatalf("ExportKAT failed: %v", err)
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	if got := hex.EncodeToString(sum[:]); got != pinnedSHA256 {
+		t.Errorf("KAT document hash changed: got %s, want %s", got, pinnedSHA256)
+	}
+}
+
+func TestVerifyDetectsTampering(t *testing.T) {
+	v := Generate()
+	v.Field.Mul[3].Result = "12345"
+
+	var buf bytes.Buffer
+	if err := writeVectors(&buf, v); err != nil {
+		t.Fatalf("failed to encode vectors: %v", err)
+	}
+
+	err := VerifyKAT(&buf)
+	if err == nil {
+		t.Fatal("VerifyKAT accepted a tampered document")
+	}
+	if !strings.Contains(err.Error(), "field mul case 3") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestVerifyRejectsMalformed(t *testing.T) {
+	inputs := []string{
+		``,
+		`{"version": 2}`,
+		`{"version": 1, "modulus": "17"}`,
+		`{"version": 1, "modulus": "18446744069414584321", "field": {"inverse": [{"a": "0", "result": "0"}]}}`,
+		`{"version": 1, "modulus": "18446744069414584321", "field": {"add": [{"a": "18446744069414584321", "b": "0", "result": "0"}]}}`,
+	}
+
+	for _, input := range inputs {
+		if err := VerifyKAT(strings.NewReader(input)); err == nil {
+			t.Errorf("VerifyKAT(%q) succeeded, expected error", input)
+		}
+	}
+}
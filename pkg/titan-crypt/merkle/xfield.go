@@ -0,0 +1,23 @@
+package merkle
+
+import (
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/hash"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/xfield"
+)
+
+// XFieldLeafDigest returns the leaf digest committing to an extension field element:
+// Tip5's variable-length hash of its three coefficients c₀, c₁, c₂.
+func XFieldLeafDigest(leaf xfield.XFieldElement) hash.Digest {
+	return hash.HashVarlen(leaf.Coefficients[:])
+}
+
+// NewTreeXField builds a MerkleTree over extension field leafs, hashing each leaf
+// with XFieldLeafDigest. Inclusion proofs are checked against the same leaf digests.
+// The same constraints on the number of leafs as for New apply.
+func NewTreeXField(leafs []xfield.XFieldElement) (*MerkleTree, error) {
+	digests := make([]hash.Digest, len(leafs))
+	for i, leaf := range leafs {
+		digests[i] = XFieldLeafDigest(leaf)
+	}
+	return New(digests)
+}
@@ -0,0 +1,96 @@
+package merkle
+
+import (
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/xfield"
+)
+
+// createTestXFieldLeafs creates distinct extension field leafs.
+func createTestXFieldLeafs(count int) []xfield.XFieldElement {
+	leafs := make([]xfield.XFieldElement, count)
+	for i := 0; i < count; i++ {
+		leafs[i] = xfield.New([3]field.Element{
+			field.New(uint64(i)),
+			field.New(uint64(i*7 + 1)),
+			field.New(uint64(i*11 + 2)),
+		})
+	}
+	return leafs
+}
+
+func TestNewTreeXFieldInclusionProof(t *testing.T) {
+	leafs := createTestXFieldLeafs(16)
+	tree, err := NewTreeXField(leafs)
+	if err != nil {
+		t.Fatalf("NewTreeXField failed: %v", err)
+	}
+
+	for i, leaf := range leafs {
+		path, err := tree.AuthenticationPath(MerkleTreeLeafIndex(i))
+		if err != nil {
+			t.Fatalf("AuthenticationPath(%d) failed: %v", i, err)
+		}
+		if !VerifyInclusionProof(tree.Root(), MerkleTreeLeafIndex(i), XFieldLeafDigest(leaf), path) {
+			t.Errorf("inclusion proof for leaf %d does not verify", i)
+		}
+	}
+
+	proof, err := tree.NewInclusionProof([]MerkleTreeLeafIndex{1, 6, 11})
+	if err != nil {
+		t.Fatalf("NewInclusionProof failed: %v", err)
+	}
+	if !proof.Verify(tree.Root()) {
+		t.Error("multi-leaf inclusion proof does not verify")
+	}
+}
+
+func TestNewTreeXFieldTamper(t *testing.T) {
+	leafs := createTestXFieldLeafs(8)
+	tree, err := NewTreeXField(leafs)
+	if err != nil {
+		t.Fatalf("NewTreeXField failed: %v", err)
+	}
+
+	const index = 5
+	path, _ := tree.AuthenticationPath(index)
+
+	// Changing any single coefficient must invalidate the proof
+	for c := 0; c < xfield.ExtensionDegree; c++ {
+		tampered := leafs[index]
+		tampered.Coefficients[c] = tampered.Coefficients[c].Add(field.One)
+		if VerifyInclusionProof(tree.Root(), index, XFieldLeafDigest(tampered), path) {
+			t.Errorf("proof verified for leaf with tampered coefficient %d", c)
+		}
+	}
+
+	// A valid leaf at the wrong index must not verify
+	if VerifyInclusionProof(tree.Root(), index-1, XFieldLeafDigest(leafs[index]), path) {
+		t.Error("proof verified at the wrong index")
+	}
+
+	// Tampering with the authentication path must fail
+	path[0][0] = path[0][0].Add(field.One)
+	if VerifyInclusionProof(tree.Root(), index, XFieldLeafDigest(leafs[index]), path) {
+		t.Error("proof verified with a tampered authentication path")
+	}
+}
+
+func TestNewTreeXFieldDistinguishesLiftedLeafs(t *testing.T) {
+	// A lifted base element must not commit to the same digest as its coefficient alone
+	a := xfield.NewConst(field.New(3))
+	b := xfield.New([3]field.Element{field.Zero, field.New(3), field.Zero})
+	if XFieldLeafDigest(a).Equal(XFieldLeafDigest(b)) {
+		t.Error("different extension elements share a leaf digest")
+	}
+}
+
+func TestNewTreeXFieldErrors(t *testing.T) {
+	if _, err := NewTreeXField(nil); err == nil {
+		t.Error("expected error for zero leafs")
+	}
+	if _, err := NewTreeXField(createTestXFieldLeafs(3)); err == nil {
+		t.Error("expected error for non-power-of-2 leaf count")
+	}
+}
@@ -0,0 +1,89 @@
+package ntt
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// Convolver computes linear convolutions against a fixed kernel, transforming the
+// kernel only once per transform size. Use it when many vectors are multiplied by
+// the same polynomial; each call to Convolve then costs one forward and one inverse
+// NTT instead of two forward and one inverse.
+//
+// A Convolver is safe for concurrent use.
+type Convolver struct {
+	kernel []field.Element
+
+	mu          sync.RWMutex
+	transformed map[int][]field.Element
+}
+
+// NewConvolver returns a Convolver for kernel. The kernel is copied and transformed
+// eagerly at the size needed for inputs up to len(kernel) elements; larger inputs
+// transform it once more at their size on first use.
+//
+// Returns an error if kernel is empty.
+func NewConvolver(kernel []field.Element) (*Convolver, error) {
+	if len(kernel) == 0 {
+		return nil, fmt.Errorf("convolution kernel must not be empty")
+	}
+
+	c := &Convolver{
+		kernel:      append([]field.Element(nil), kernel...),
+		transformed: make(map[int][]field.Element),
+	}
+	c.kernelTransform(NextPowerOfTwo(2*len(kernel) - 1))
+	return c, nil
+}
+
+// Convolve returns the linear convolution of the kernel with x, i.e. the
+// coefficients of the product of the two polynomials they represent. The result
+// has length len(kernel)+len(x)-1 and is not trimmed of trailing zeros.
+// An empty x yields an empty result.
+func (c *Convolver) Convolve(x []field.Element) []field.Element {
+	if len(x) == 0 {
+		return []field.Element{}
+	}
+
+	resultLen := len(c.kernel) + len(x) - 1
+	size := NextPowerOfTwo(resultLen)
+	kernel := c.kernelTransform(size)
+
+	values := make([]field.Element, size)
+	copy(values, x)
+	NTT(values)
+
+	for i := range values {
+		values[i] = values[i].Mul(kernel[i])
+	}
+	INTT(values)
+
+	return values[:resultLen]
+}
+
+// kernelTransform returns the NTT of the zero-padded kernel at the given size.
+func (c *Convolver) kernelTransform(size int) []field.Element {
+	c.mu.RLock()
+	transformed, ok := c.transformed[size]
+	c.mu.RUnlock()
+	if ok {
+		return transformed
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Double-check after acquiring write lock
+	if transformed, ok := c.transformed[size]; ok {
+		return transformed
+	}
+
+	transformed = make([]field.Element, size)
+	copy(transformed, c.kernel)
+	NTT(transformed)
+
+	c.transformed[size] = transformed
+	return transformed
+}
@@ -0,0 +1,41 @@
+package ntt
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func randomElements(rng *rand.Rand, n int) []field.Element {
+	values := make([]field.Element, n)
+	for i := range values {
+		values[i] = field.New(rng.Uint64())
+	}
+	return values
+}
+
+func TestConvolverDoesNotAliasKernel(t *testing.T) {
+	kernel := []field.Element{field.New(1), field.New(2)}
+	c, err := NewConvolver(kernel)
+	if err != nil {
+		t.Fatalf("NewConvolver failed: %v", err)
+	}
+	kernel[0] = field.New(100)
+
+	got := c.Convolve([]field.Element{field.One})
+	if !got[0].Equal(field.One) || !got[1].Equal(field.New(2)) {
+		t.Errorf("Convolve used the caller's modified kernel: %v", got)
+	}
+}
+
+func TestConvolverEdgeCases(t *testing.T) {
+	if _, err := NewConvolver(nil); err == nil {
+		t.Error("expected error for empty kernel")
+	}
+
+	c, _ := NewConvolver([]field.Element{field.New(3)})
+	if got := c.Convolve(nil); len(got) != 0 {
+		t.Errorf("Convolve(nil) = %v, want empty", got)
+	}
+}
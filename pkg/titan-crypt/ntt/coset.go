@@ -0,0 +1,104 @@
+package ntt
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/internal/parallel"
+)
+
+// EvaluateOnCoset evaluates the polynomial with the given coefficients at every
+// point offset·ωⁱ, i < order, where ω is the primitive root of unity of that order.
+// The coefficients are scaled by the powers of offset and then transformed, so
+// result[i] = Σₖ coeffs[k]·(offset·ωⁱ)ᵏ.
+//
+// Returns an error if order is not a power of 2 (or exceeds 2^31) or if
+// len(coeffs) > order.
+func EvaluateOnCoset(coeffs []field.Element, order uint64, offset field.Element) ([]field.Element, error) {
+	return evaluateOnCoset(coeffs, order, offset, nil, nil)
+}
+
+// BatchEvaluateOnCoset evaluates every polynomial on the same coset, with
+// result[i] equal to EvaluateOnCoset(polys[i], order, offset).
+//
+// The powers of offset and the root table are computed once and shared by all
+// polynomials, which are spread over runtime.GOMAXPROCS(0) goroutines. This is the
+// low-degree extension step of a prover, where many columns share one domain.
+//
+// Returns an error if order is not a power of 2 (or exceeds 2^31) or if any
+// polynomial has more than order coefficients.
+func BatchEvaluateOnCoset(polys [][]field.Element, order uint64, offset field.Element) ([][]field.Element, error) {
+	if err := checkCosetOrder(order); err != nil {
+		return nil, err
+	}
+	maxLen := 0
+	for i, coeffs := range polys {
+		if uint64(len(coeffs)) > order {
+			return nil, fmt.Errorf("polynomial %d has %d coefficients, more than the coset order %d", i, len(coeffs), order)
+		}
+		if len(coeffs) > maxLen {
+			maxLen = len(coeffs)
+		}
+	}
+
+	offsetPowers := powersOf(offset, maxLen)
+	roots := getRoots(uint32(order), false)
+
+	result := make([][]field.Element, len(polys))
+	evalRow := func(i int) {
+		// Inputs were validated above, so this cannot fail.
+		result[i], _ = evaluateOnCoset(polys[i], order, offset, offsetPowers, roots)
+	}
+
+	workers := min(runtime.GOMAXPROCS(0), len(polys))
+	parallel.Parts(workers, func(w int) {
+		for i := w; i < len(polys); i += workers {
+			evalRow(i)
+		}
+	})
+
+	return result, nil
+}
+
+// evaluateOnCoset is EvaluateOnCoset with optional precomputed tables: offsetPowers
+// must hold at least len(coeffs) powers of offset and roots must be the forward
+// twiddle table getRoots returns for the given order. Nil tables are computed on the fly.
+func evaluateOnCoset(coeffs []field.Element, order uint64, offset field.Element, offsetPowers, roots []field.Element) ([]field.Element, error) {
+	if err := checkCosetOrder(order); err != nil {
+		return nil, err
+	}
+	if uint64(len(coeffs)) > order {
+		return nil, fmt.Errorf("polynomial has %d coefficients, more than the coset order %d", len(coeffs), order)
+	}
+	if offsetPowers == nil {
+		offsetPowers = powersOf(offset, len(coeffs))
+	}
+	if roots == nil {
+		roots = getRoots(uint32(order), false)
+	}
+
+	values := make([]field.Element, order)
+	for k, c := range coeffs {
+		values[k] = c.Mul(offsetPowers[k])
+	}
+	transform(values, roots, false)
+	return values, nil
+}
+
+func checkCosetOrder(order uint64) error {
+	if order == 0 || order&(order-1) != 0 {
+		return fmt.Errorf("coset order must be a power of 2, got %d", order)
+	}
+	if order > (1 << 31) {
+		return fmt.Errorf("coset order too large: %d", order)
+	}
+	return nil
+}
+
+// powersOf returns [1, x, x², …, x^(n-1)].
+func powersOf(x field.Element, n int) []field.Element {
+	powers := make([]field.Element, n)
+	field.Powers(powers, x)
+	return powers
+}
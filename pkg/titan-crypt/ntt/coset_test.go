@@ -0,0 +1,113 @@
+package ntt
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func hornerEval(coeffs []field.Element, x field.Element) field.Element {
+	acc := field.Zero
+	for k := len(coeffs) - 1; k >= 0; k-- {
+		acc = acc.Mul(x).Add(coeffs[k])
+	}
+	return acc
+}
+
+func TestEvaluateOnCosetMatchesDirect(t *testing.T) {
+	rng := rand.New(rand.NewSource(417))
+	offset := field.Generator()
+
+	for _, tc := range []struct{ numCoeffs, order int }{{0, 4}, {1, 1}, {3, 4}, {16, 16}, {10, 64}} {
+		coeffs := randomElements(rng, tc.numCoeffs)
+		got, err := EvaluateOnCoset(coeffs, uint64(tc.order), offset)
+		if err != nil {
+			t.Fatalf("EvaluateOnCoset(%d, %d) failed: %v", tc.numCoeffs, tc.order, err)
+		}
+		if len(got) != tc.order {
+			t.Fatalf("got %d evaluations, want %d", len(got), tc.order)
+		}
+
+		omega := field.PrimitiveRootOfUnity(uint64(tc.order))
+		point := offset
+		for i := range got {
+			if want := hornerEval(coeffs, point); !got[i].Equal(want) {
+				t.Fatalf("order %d: evaluation %d = %v, want %v", tc.order, i, got[i], want)
+			}
+			point = point.Mul(omega)
+		}
+	}
+}
+
+func TestBatchEvaluateOnCosetMatchesSingle(t *testing.T) {
+	rng := rand.New(rand.NewSource(417))
+	offset := field.Generator()
+	const order = 256
+
+	polys := make([][]field.Element, 9)
+	for i := range polys {
+		polys[i] = randomElements(rng, rng.Intn(order+1))
+	}
+
+	got, err := BatchEvaluateOnCoset(polys, order, offset)
+	if err != nil {
+		t.Fatalf("BatchEvaluateOnCoset failed: %v", err)
+	}
+	for i, coeffs := range polys {
+		want, err := EvaluateOnCoset(coeffs, order, offset)
+		if err != nil {
+			t.Fatalf("EvaluateOnCoset failed: %v", err)
+		}
+		for j := range want {
+			if !got[i][j].Equal(want[j]) {
+				t.Fatalf("polynomial %d, evaluation %d = %v, want %v", i, j, got[i][j], want[j])
+			}
+		}
+	}
+}
+
+func TestEvaluateOnCosetErrors(t *testing.T) {
+	offset := field.Generator()
+	if _, err := EvaluateOnCoset(nil, 12, offset); err == nil {
+		t.Error("non-power-of-2 order should fail")
+	}
+	if _, err := EvaluateOnCoset(make([]field.Element, 5), 4, offset); err == nil {
+		t.Error("more coefficients than the order should fail")
+	}
+	if _, err := BatchEvaluateOnCoset([][]field.Element{make([]field.Element, 2), make([]field.Element, 9)}, 8, offset); err == nil {
+		t.Error("batch with an oversized polynomial should fail")
+	}
+}
+
+// BenchmarkBatchEvaluateOnCoset and BenchmarkEvaluateOnCosetLoop extend 64
+// polynomials over a 2^16 trace domain with blowup 4.
+func BenchmarkBatchEvaluateOnCoset(b *testing.B) {
+	polys, order := lowDegreeExtensionInput()
+	offset := field.Generator()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = BatchEvaluateOnCoset(polys, order, offset)
+	}
+}
+
+func BenchmarkEvaluateOnCosetLoop(b *testing.B) {
+	polys, order := lowDegreeExtensionInput()
+	offset := field.Generator()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, coeffs := range polys {
+			_, _ = EvaluateOnCoset(coeffs, order, offset)
+		}
+	}
+}
+
+func lowDegreeExtensionInput() ([][]field.Element, uint64) {
+	const numPolys, traceLen, blowup = 64, 1 << 16, 4
+	rng := rand.New(rand.NewSource(417))
+	polys := make([][]field.Element, numPolys)
+	for i := range polys {
+		polys[i] = randomElements(rng, traceLen)
+	}
+	return polys, traceLen * blowup
+}
@@ -13,10 +13,8 @@ import (
 	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
 )
 
-// Cache for twiddle factors to avoid recomputation
+// Cache for bit-reversal permutations; root tables live in field.DefaultRootProvider
 var (
-	nttTwiddleCache  = make(map[uint32][][]field.Element)
-	inttTwiddleCache = make(map[uint32][][]field.Element)
 	swapIndicesCache = make(map[uint32][]int)
 	cacheMutex       sync.RWMutex
 )
@@ -35,19 +33,11 @@ func NTT(x []field.Element) {
 		return
 	}
 
-	// Validate length
-	if n&(n-1) != 0 {
-		panic(fmt.Sprintf("NTT requires power-of-2 length, got %d", n))
-	}
-	if n > (1 << 31) {
-		panic(fmt.Sprintf("NTT length too large: %d", n))
+	if err := checkLength("NTT", n); err != nil {
+		panic(err.Error())
 	}
 
-	// Get or compute twiddle factors
-	twiddles := getTwiddleFactors(uint32(n), false)
-
-	// Perform NTT
-	nttUnchecked(x, twiddles)
+	transform(x, getRoots(uint32(n), false), false)
 }
 
 // INTT performs an in-place Inverse Number Theoretic Transform.
@@ -64,62 +54,158 @@ func INTT(x []field.Element) {
 		return
 	}
 
-	// Validate length
+	if err := checkLength("INTT", n); err != nil {
+		panic(err.Error())
+	}
+
+	transform(x, getRoots(uint32(n), true), true)
+}
+
+// Forward is NTT under the name used by transform-agnostic callers; it
+// transforms x in place with the same output ordering. Like NTT it supports
+// power-of-2 lengths up to 2^31, not 2^32: the transform indexes with uint32,
+// which cannot hold a length of 2^32.
+//
+// Panics under the same conditions as NTT.
+func Forward(x []field.Element) {
+	NTT(x)
+}
+
+// Inverse is INTT under the name used by transform-agnostic callers, the
+// in-place inverse of Forward, with the same 2^31 length limit.
+//
+// Panics under the same conditions as INTT.
+func Inverse(x []field.Element) {
+	INTT(x)
+}
+
+// maxLength is the largest transform length NTT and INTT accept.
+const maxLength = 1 << 31
+
+// checkLength returns an error naming op if n is not a power of 2 or exceeds
+// maxLength.
+func checkLength(op string, n int) error {
 	if n&(n-1) != 0 {
-		panic(fmt.Sprintf("INTT requires power-of-2 length, got %d", n))
+		return fmt.Errorf("%s requires power-of-2 length, got %d", op, n)
+	}
+	if n > maxLength {
+		return fmt.Errorf("%s length too large: %d", op, n)
+	}
+	return nil
+}
+
+// NTTWithRoots returns the transform of data computed with a caller-supplied
+// root table, leaving data untouched. roots[j] must hold ω^j for j < len(data)/2,
+// where ω is a root of unity of order len(data).
+//
+// Passing the powers of the standard primitive root reproduces NTT; passing the
+// powers of its inverse and multiplying the result by 1/len(data) reproduces INTT.
+// Other roots of unity of the same order yield the transform over that root.
+//
+// Returns an error if len(data) is not a power of 2 (or exceeds 2^31) or if
+// len(roots) != len(data)/2.
+func NTTWithRoots(data, roots []field.Element) ([]field.Element, error) {
+	n := len(data)
+	if n == 0 {
+		return []field.Element{}, nil
+	}
+	if n&(n-1) != 0 {
+		return nil, fmt.Errorf("NTT requires power-of-2 length, got %d", n)
 	}
 	if n > (1 << 31) {
-		panic(fmt.Sprintf("INTT length too large: %d", n))
+		return nil, fmt.Errorf("NTT length too large: %d", n)
+	}
+	if len(roots) != n/2 {
+		return nil, fmt.Errorf("NTT of length %d requires %d roots, got %d", n, n/2, len(roots))
 	}
 
-	// Get or compute twiddle factors (using inverse root of unity)
-	twiddles := getTwiddleFactors(uint32(n), true)
+	result := make([]field.Element, n)
+	copy(result, data)
+	if n == 1 {
+		return result, nil
+	}
 
-	// Perform INTT
-	nttUnchecked(x, twiddles)
+	// Lay the roots out as getRoots does: twiddles[i] = ω^rev(i) over log2(n/2) bits
+	twiddles := make([]field.Element, n/2)
+	shift := 64 - bits.TrailingZeros64(uint64(n/2))
+	for i := range twiddles {
+		twiddles[i] = roots[bits.Reverse64(uint64(i))>>shift]
+	}
 
-	// Unscale by multiplying by 1/n
-	unscale(x)
+	transform(result, twiddles, false)
+	return result, nil
 }
 
-// nttUnchecked performs the core NTT algorithm.
+// transform performs the core NTT algorithm in place: radix-2 Cooley-Tukey
+// butterflies from natural to bit-reversed order, followed by a bit-reversal
+// permutation. Layer by layer the butterfly half-width h halves from n/2 to 1;
+// the layer splits x into blocks of width 2h, and every butterfly of block b
+// uses the same twiddle twiddles[b] = ω^rev(b), with rev over log2(n/2) bits.
+// The twiddles of a layer are thus read in sequence, one per block, which is
+// what makes the bit-reversed table faster than strided reads of ω^j on large
+// inputs. If invert is true, the result is scaled by 1/n.
+//
 // Assumes:
-// - len(x) is a power of 2
-// - twiddle factors are correct for len(x)
+//   - len(x) is a power of 2
+//   - twiddles[i] = ω^rev(i) for i < len(x)/2, rev over log2(len(x)/2) bits,
+//     as returned by getRoots
 //
-// This is equivalent to twenty-first's ntt_unchecked().
-func nttUnchecked(x []field.Element, twiddles [][]field.Element) {
+// The output matches twenty-first's ntt_unchecked().
+func transform(x []field.Element, twiddles []field.Element, invert bool) {
 	n := uint32(len(x))
 	if n <= 1 {
 		return
 	}
 
-	// Bit-reverse permutation
-	swapIndices := getSwapIndices(n)
-	for i, revI := range swapIndices {
-		if revI > 0 {
-			x[i], x[revI] = x[revI], x[i]
+	for h := n / 2; h >= 1; h /= 2 {
+		for b, start := uint32(0), uint32(0); start < n; b, start = b+1, start+2*h {
+			w := twiddles[b]
+			top, bottom := x[start:start+h], x[start+h:start+2*h]
+			for j := range top {
+				u := top[j]
+				v := bottom[j].Mul(w)
+				top[j] = u.Add(v)
+				bottom[j] = u.Sub(v)
+			}
 		}
 	}
 
-	// Cooley-Tukey butterfly operations
-	m := uint32(1)
-	for _, twiddleRow := range twiddles {
-		k := uint32(0)
-		for k < n {
-			for j := uint32(0); j < m; j++ {
-				idx1 := k + j
-				idx2 := k + j + m
+	bitReversePermute(x)
 
-				u := x[idx1]
-				v := x[idx2].Mul(twiddleRow[j])
+	if invert {
+		unscale(x)
+	}
+}
 
-				x[idx1] = u.Add(v)
-				x[idx2] = u.Sub(v)
-			}
-			k += 2 * m
+// butterflies applies butterflies lo through hi-1 of the layer with half-width
+// h, for transforms split across goroutines. The layer has len(x)/2 butterflies; butterfly k lies in block b = ⌊k/h⌋
+// and combines x[2h·b + j] and x[2h·b + j + h], where j = k mod h, with the
+// twiddle twiddles[b].
+func butterflies(x, twiddles []field.Element, h, lo, hi uint32) {
+	for k := lo; k < hi; {
+		b, j := k/h, k%h
+		end := min(h, j+hi-k)
+		k += end - j
+
+		w := twiddles[b]
+		block := x[2*h*b : 2*h*(b+1)]
+		top, bottom := block[j:end], block[h+j:h+end]
+		for i := range top {
+			u := top[i]
+			v := bottom[i].Mul(w)
+			top[i] = u.Add(v)
+			bottom[i] = u.Sub(v)
+		}
+	}
+}
+
+// bitReversePermute swaps every x[i] with x[rev(i)], rev over log2(len(x)) bits.
+func bitReversePermute(x []field.Element) {
+	swapIndices := getSwapIndices(uint32(len(x)))
+	for i, revI := range swapIndices {
+		if revI > 0 {
+			x[i], x[revI] = x[revI], x[i]
 		}
-		m *= 2
 	}
 }
 
@@ -136,60 +222,21 @@ func unscale(x []field.Element) {
 	}
 }
 
-// getTwiddleFactors returns the twiddle factors for NTT/INTT of given length.
-// Results are cached for performance.
-//
-// If inverse is true, uses the inverse root of unity (for INTT).
-func getTwiddleFactors(n uint32, inverse bool) [][]field.Element {
-	cacheMutex.RLock()
-	cache := nttTwiddleCache
-	if inverse {
-		cache = inttTwiddleCache
-	}
-	if twiddles, ok := cache[n]; ok {
-		cacheMutex.RUnlock()
-		return twiddles
-	}
-	cacheMutex.RUnlock()
-
-	// Compute twiddle factors
-	cacheMutex.Lock()
-	defer cacheMutex.Unlock()
-
-	// Double-check after acquiring write lock
-	if twiddles, ok := cache[n]; ok {
-		return twiddles
-	}
-
-	// Get primitive root of unity for this domain size
-	omega := field.PrimitiveRootOfUnity(uint64(n))
-	if omega.IsZero() {
-		panic(fmt.Sprintf("no primitive root of unity for n=%d", n))
-	}
-
+// getRoots returns the twiddle table transform expects for length n:
+// [ω^rev(0), …, ω^rev(n/2-1)] for the primitive root ω of order n, or of its
+// inverse if inverse is true, with rev over log2(n/2) bits. The table is the
+// bit-reversed one shared by field.DefaultRootProvider.
+func getRoots(n uint32, inverse bool) []field.Element {
+	var twiddles []field.Element
+	var err error
 	if inverse {
-		omega = omega.Inverse()
+		twiddles, err = field.DefaultRootProvider.BitReversedInverseRootPowers(uint64(n))
+	} else {
+		twiddles, err = field.DefaultRootProvider.BitReversedRootPowers(uint64(n))
 	}
-
-	// Compute twiddle factors
-	log2N := bits.Len32(n) - 1
-	twiddles := make([][]field.Element, log2N)
-
-	for i := uint32(0); i < uint32(log2N); i++ {
-		m := uint32(1) << i
-		exponent := n / (2 * m)
-		wm := omega.ModPow(uint64(exponent))
-
-		twiddleRow := make([]field.Element, m)
-		twiddleRow[0] = field.One
-		for j := uint32(1); j < m; j++ {
-			twiddleRow[j] = twiddleRow[j-1].Mul(wm)
-		}
-
-		twiddles[i] = twiddleRow
+	if err != nil {
+		panic(fmt.Sprintf("no primitive root of unity for n=%d: %v", n, err))
 	}
-
-	cache[n] = twiddles
 	return twiddles
 }
 
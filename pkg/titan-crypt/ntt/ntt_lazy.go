@@ -0,0 +1,77 @@
+package ntt
+
+import (
+	"fmt"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// NTTLazy performs the same in-place transform as NTT, but keeps intermediate
+// butterfly values in lazy (non-canonical) form and canonicalizes only once at
+// the end. The output is identical to NTT.
+//
+// This is an experimental path; see field.LazyElement. NTT remains the default;
+// compare BenchmarkNTTLazy65536 with BenchmarkNTTStrict65536 before switching.
+//
+// Panics if:
+// - len(x) is not a power of 2
+// - len(x) > 2^31
+func NTTLazy(x []field.Element) {
+	n := len(x)
+	if n == 0 {
+		return
+	}
+
+	// Validate length
+	if n&(n-1) != 0 {
+		panic(fmt.Sprintf("NTT requires power-of-2 length, got %d", n))
+	}
+	if n > (1 << 31) {
+		panic(fmt.Sprintf("NTT length too large: %d", n))
+	}
+
+	twiddles := getRoots(uint32(n), false)
+
+	lazy := make([]field.LazyElement, n)
+	for i, e := range x {
+		lazy[i] = e.Lazy()
+	}
+
+	nttLazyUnchecked(lazy, twiddles)
+
+	for i, e := range lazy {
+		x[i] = e.Freeze()
+	}
+}
+
+// nttLazyUnchecked is transform over lazily reduced elements, without scaling.
+func nttLazyUnchecked(x []field.LazyElement, twiddles []field.Element) {
+	n := uint32(len(x))
+	if n <= 1 {
+		return
+	}
+
+	// Cooley-Tukey butterflies from natural to bit-reversed order, one
+	// twiddle per block as in transform
+	for h := n / 2; h >= 1; h /= 2 {
+		for b := uint32(0); b < n/(2*h); b++ {
+			w := twiddles[b]
+			block := x[2*h*b : 2*h*(b+1)]
+			for j := uint32(0); j < h; j++ {
+				u := block[j]
+				v := block[j+h].Mul(w)
+
+				block[j] = u.AddElement(v)
+				block[j+h] = u.SubElement(v)
+			}
+		}
+	}
+
+	// Bit-reverse permutation
+	swapIndices := getSwapIndices(n)
+	for i, revI := range swapIndices {
+		if revI > 0 {
+			x[i], x[revI] = x[revI], x[i]
+		}
+	}
+}
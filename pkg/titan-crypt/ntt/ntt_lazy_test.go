@@ -0,0 +1,71 @@
+package ntt
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestNTTLazyMatchesNTT(t *testing.T) {
+	rng := rand.New(rand.NewSource(383))
+
+	for _, size := range []int{0, 1, 2, 4, 8, 64, 1024, 4096} {
+		strict := make([]field.Element, size)
+		for i := range strict {
+			// Mix in stored Montgomery words near P to exercise the lazy
+			// carry paths; New(P-1-k) would store words far from P
+			if i%3 == 0 {
+				strict[i] = field.NewFromRaw(field.P - 1 - uint64(rng.Intn(16)))
+			} else {
+				strict[i] = field.New(rng.Uint64())
+			}
+		}
+		lazy := make([]field.Element, size)
+		copy(lazy, strict)
+
+		NTT(strict)
+		NTTLazy(lazy)
+
+		for i := range strict {
+			if !strict[i].Equal(lazy[i]) {
+				t.Fatalf("size %d: mismatch at index %d: NTT = %v, NTTLazy = %v",
+					size, i, strict[i], lazy[i])
+			}
+		}
+	}
+}
+
+func TestNTTLazyPanicOnNonPowerOfTwo(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("NTTLazy should panic on non-power-of-2 length")
+		}
+	}()
+
+	NTTLazy(make([]field.Element, 6))
+}
+
+func benchmarkButterflyInput(n int) []field.Element {
+	data := make([]field.Element, n)
+	for i := range data {
+		data[i] = field.New(uint64(i*7 + 13))
+	}
+	return data
+}
+
+func BenchmarkNTTStrict65536(b *testing.B) {
+	data := benchmarkButterflyInput(65536)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NTT(data)
+	}
+}
+
+func BenchmarkNTTLazy65536(b *testing.B) {
+	data := benchmarkButterflyInput(65536)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NTTLazy(data)
+	}
+}
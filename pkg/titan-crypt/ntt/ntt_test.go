@@ -272,3 +272,223 @@ func BenchmarkNTTINTTRoundTrip1024(b *testing.B) {
 		INTT(values)
 	}
 }
+
+func TestNTTSharedRootsMatchStandalone(t *testing.T) {
+	// Twiddles come from field.DefaultRootProvider; compare against a naive DFT
+	// whose root powers are computed independently of the provider
+	for _, size := range []int{2, 8, 64, 256} {
+		values := make([]field.Element, size)
+		for i := range values {
+			values[i] = field.New(uint64(i*i + 5))
+		}
+
+		omega, err := field.GetPrimitiveRoot(uint64(size))
+		if err != nil {
+			t.Fatalf("GetPrimitiveRoot(%d) failed: %v", size, err)
+		}
+
+		want := make([]field.Element, size)
+		for k := range want {
+			wk := omega.ModPow(uint64(k))
+			acc := field.Zero
+			for i := size - 1; i >= 0; i-- {
+				acc = acc.Mul(wk).Add(values[i])
+			}
+			want[k] = acc
+		}
+
+		got := make([]field.Element, size)
+		copy(got, values)
+		NTT(got)
+		for k := range want {
+			if !got[k].Equal(want[k]) {
+				t.Fatalf("size %d: NTT[%d] = %v, want %v", size, k, got[k], want[k])
+			}
+		}
+
+		INTT(got)
+		for i := range values {
+			if !got[i].Equal(values[i]) {
+				t.Fatalf("size %d: INTT round trip failed at %d", size, i)
+			}
+		}
+	}
+}
+
+func TestNTTWithRoots(t *testing.T) {
+	for _, size := range []int{1, 2, 8, 256} {
+		values := make([]field.Element, size)
+		for i := range values {
+			values[i] = field.New(uint64(3*i + 1))
+		}
+
+		forward, err := field.DefaultRootProvider.RootPowers(uint64(size))
+		if err != nil {
+			t.Fatalf("RootPowers failed: %v", err)
+		}
+		got, err := NTTWithRoots(values, forward[:size/2])
+		if err != nil {
+			t.Fatalf("NTTWithRoots failed: %v", err)
+		}
+
+		want := append([]field.Element(nil), values...)
+		NTT(want)
+		for i := range want {
+			if !got[i].Equal(want[i]) {
+				t.Fatalf("size %d: forward roots: result[%d] = %v, want NTT %v", size, i, got[i], want[i])
+			}
+		}
+
+		// Inverse roots plus scaling by 1/n reproduce INTT
+		inverse, _ := field.DefaultRootProvider.InverseRootPowers(uint64(size))
+		back, err := NTTWithRoots(got, inverse[:size/2])
+		if err != nil {
+			t.Fatalf("NTTWithRoots failed: %v", err)
+		}
+		nInv := field.New(uint64(size)).Inverse()
+		INTT(want)
+		for i := range back {
+			if scaled := back[i].Mul(nInv); !scaled.Equal(want[i]) || !scaled.Equal(values[i]) {
+				t.Fatalf("size %d: inverse roots: result[%d] = %v, want %v", size, i, scaled, values[i])
+			}
+		}
+	}
+}
+
+func TestNTTWithRootsDoesNotModifyInput(t *testing.T) {
+	values := []field.Element{field.New(1), field.New(2), field.New(3), field.New(4)}
+	roots, _ := field.DefaultRootProvider.RootPowers(4)
+	if _, err := NTTWithRoots(values, roots[:2]); err != nil {
+		t.Fatalf("NTTWithRoots failed: %v", err)
+	}
+	for i, v := range values {
+		if !v.Equal(field.New(uint64(i + 1))) {
+			t.Fatalf("input modified at %d", i)
+		}
+	}
+}
+
+func TestNTTWithRootsErrors(t *testing.T) {
+	roots, _ := field.DefaultRootProvider.RootPowers(8)
+
+	if _, err := NTTWithRoots(make([]field.Element, 6), roots[:3]); err == nil {
+		t.Error("expected error for non-power-of-2 length")
+	}
+	if _, err := NTTWithRoots(make([]field.Element, 8), roots[:3]); err == nil {
+		t.Error("expected error for too few roots")
+	}
+	if _, err := NTTWithRoots(make([]field.Element, 8), roots); err == nil {
+		t.Error("expected error for too many roots")
+	}
+	if got, err := NTTWithRoots(nil, nil); err != nil || len(got) != 0 {
+		t.Errorf("NTTWithRoots(nil, nil) = %v, %v", got, err)
+	}
+}
+
+func TestNTTDegenerateSizes(t *testing.T) {
+	a, b := field.New(42), field.New(1000)
+
+	// Size 1: the DFT of size 1 is the identity, in both directions.
+	one := []field.Element{a}
+	NTT(one)
+	if !one[0].Equal(a) {
+		t.Errorf("NTT of size 1 = %v, want %v", one[0], a)
+	}
+	INTT(one)
+	if !one[0].Equal(a) {
+		t.Errorf("INTT of size 1 = %v, want %v", one[0], a)
+	}
+	lazy := []field.Element{a}
+	NTTLazy(lazy)
+	if !lazy[0].Equal(a) {
+		t.Errorf("NTTLazy of size 1 = %v, want %v", lazy[0], a)
+	}
+	withRoots, err := NTTWithRoots([]field.Element{a}, nil)
+	if err != nil || !withRoots[0].Equal(a) {
+		t.Errorf("NTTWithRoots of size 1 = %v, %v; want [%v]", withRoots, err, a)
+	}
+	plan, err := NewPlan(1)
+	if err != nil {
+		t.Fatalf("NewPlan(1) failed: %v", err)
+	}
+	plan.Forward(one)
+	plan.Inverse(one)
+	if !one[0].Equal(a) {
+		t.Errorf("Plan of size 1 changed the input to %v", one[0])
+	}
+
+	// Size 2: ω = -1, so NTT([a, b]) = [a+b, a-b] and INTT divides by 2.
+	two := []field.Element{a, b}
+	NTT(two)
+	if !two[0].Equal(a.Add(b)) || !two[1].Equal(a.Sub(b)) {
+		t.Errorf("NTT([a, b]) = %v, want [a+b, a-b]", two)
+	}
+	INTT(two)
+	if !two[0].Equal(a) || !two[1].Equal(b) {
+		t.Errorf("INTT(NTT([a, b])) = %v, want [%v, %v]", two, a, b)
+	}
+
+	halfInv := field.New(2).Inverse()
+	inv := []field.Element{a, b}
+	INTT(inv)
+	if !inv[0].Equal(a.Add(b).Mul(halfInv)) || !inv[1].Equal(a.Sub(b).Mul(halfInv)) {
+		t.Errorf("INTT([a, b]) = %v, want [(a+b)/2, (a-b)/2]", inv)
+	}
+}
+
+func TestEvaluateOnCosetDegenerateSizes(t *testing.T) {
+	c0, c1 := field.New(7), field.New(11)
+	offset := field.Generator()
+
+	got, err := EvaluateOnCoset([]field.Element{c0}, 1, offset)
+	if err != nil || len(got) != 1 || !got[0].Equal(c0) {
+		t.Errorf("EvaluateOnCoset of order 1 = %v, %v; want [%v]", got, err, c0)
+	}
+
+	got, err = EvaluateOnCoset([]field.Element{c0, c1}, 2, offset)
+	if err != nil {
+		t.Fatalf("EvaluateOnCoset of order 2 failed: %v", err)
+	}
+	if !got[0].Equal(c0.Add(c1.Mul(offset))) || !got[1].Equal(c0.Sub(c1.Mul(offset))) {
+		t.Errorf("EvaluateOnCoset of order 2 = %v, want [c0+c1·g, c0-c1·g]", got)
+	}
+}
+
+func TestForwardInverseMatchNTT(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 64, 1024} {
+		x := make([]field.Element, size)
+		for i := range x {
+			x[i] = field.New(uint64(i*i + 555))
+		}
+		want := append([]field.Element(nil), x...)
+		NTT(want)
+
+		got := append([]field.Element(nil), x...)
+		Forward(got)
+		for i := range got {
+			if !got[i].Equal(want[i]) {
+				t.Fatalf("size %d: Forward differs from NTT at %d", size, i)
+			}
+		}
+
+		Inverse(got)
+		for i := range got {
+			if !got[i].Equal(x[i]) {
+				t.Fatalf("size %d: Inverse did not undo Forward at %d", size, i)
+			}
+		}
+	}
+}
+
+func TestCheckLengthBoundary(t *testing.T) {
+	for _, n := range []int{1, 2, 1 << 20, maxLength} {
+		if err := checkLength("NTT", n); err != nil {
+			t.Errorf("checkLength(%d) = %v, want nil", n, err)
+		}
+	}
+	for _, n := range []int{3, maxLength - 1, maxLength + 1, 2 * maxLength} {
+		if err := checkLength("NTT", n); err == nil {
+			t.Errorf("checkLength(%d) accepted an unsupported length", n)
+		}
+	}
+}
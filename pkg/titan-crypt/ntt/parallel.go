@@ -0,0 +1,123 @@
+package ntt
+
+import (
+	"fmt"
+	"math/bits"
+	"runtime"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/internal/parallel"
+)
+
+// parallelThreshold is the transform length below which ParallelNTT and
+// ParallelINTT run the sequential transform; smaller inputs do not amortize
+// the goroutine start-up and per-layer synchronization.
+const parallelThreshold = 1 << 14
+
+// ParallelNTT performs the same in-place transform as NTT, spread over the
+// given number of goroutines. If workers <= 0, runtime.GOMAXPROCS(0) workers
+// are used. The output is identical to NTT.
+//
+// The input is split into one contiguous chunk per worker, rounded to a power
+// of 2. The first log₂(chunks) butterfly layers have blocks spanning chunks;
+// each of them splits its butterflies across the workers and ends with a
+// barrier. The remaining layers and the bit-reversal permutation then run on
+// each chunk independently, without synchronization.
+//
+// Panics under the same conditions as NTT.
+func ParallelNTT(x []field.Element, workers int) {
+	n := len(x)
+	if n == 0 {
+		return
+	}
+	if n&(n-1) != 0 {
+		panic(fmt.Sprintf("NTT requires power-of-2 length, got %d", n))
+	}
+	if n > (1 << 31) {
+		panic(fmt.Sprintf("NTT length too large: %d", n))
+	}
+
+	parallelTransform(x, getRoots(uint32(n), false), false, workers)
+}
+
+// ParallelINTT performs the same in-place inverse transform as INTT, spread
+// over the given number of goroutines as ParallelNTT does. If workers <= 0,
+// runtime.GOMAXPROCS(0) workers are used. The output is identical to INTT.
+//
+// Panics under the same conditions as INTT.
+func ParallelINTT(x []field.Element, workers int) {
+	n := len(x)
+	if n == 0 {
+		return
+	}
+	if n&(n-1) != 0 {
+		panic(fmt.Sprintf("INTT requires power-of-2 length, got %d", n))
+	}
+	if n > (1 << 31) {
+		panic(fmt.Sprintf("INTT length too large: %d", n))
+	}
+
+	parallelTransform(x, getRoots(uint32(n), true), true, workers)
+}
+
+// parallelTransform is transform split across workers goroutines.
+//
+// Assumes:
+// - len(x) is a power of 2
+// - twiddles is the table getRoots returns for len(x)
+func parallelTransform(x []field.Element, twiddles []field.Element, invert bool, workers int) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	n := uint32(len(x))
+	if workers == 1 || n < parallelThreshold {
+		transform(x, twiddles, invert)
+		return
+	}
+
+	// One chunk per worker, rounded up to a power of 2; every chunk keeps at
+	// least one full butterfly
+	chunks := min(uint32(1)<<bits.Len32(uint32(workers)-1), n/2)
+	chunkLen := n / chunks
+	half := chunkLen / 2
+
+	// The widest layers have blocks spanning chunks; split each one by
+	// butterfly index
+	h := n / 2
+	for ; 2*h > chunkLen; h /= 2 {
+		parallel.Parts(int(chunks), func(c int) {
+			lo := uint32(c) * half
+			butterflies(x, twiddles, h, lo, lo+half)
+		})
+	}
+
+	// Layers whose blocks fit in a chunk stay within it
+	parallel.Parts(int(chunks), func(c int) {
+		lo := uint32(c) * half
+		for m := h; m >= 1; m /= 2 {
+			butterflies(x, twiddles, m, lo, lo+half)
+		}
+	})
+
+	// Each swap pair is owned by its lower index, so chunks of indices
+	// never touch the same pair
+	swapIndices := getSwapIndices(n)
+	parallel.Parts(int(chunks), func(c int) {
+		start := uint32(c) * chunkLen
+		for i := start; i < start+chunkLen; i++ {
+			if revI := swapIndices[i]; revI > 0 {
+				x[i], x[revI] = x[revI], x[i]
+			}
+		}
+	})
+
+	if invert {
+		nInv := field.New(uint64(n)).Inverse()
+		parallel.Parts(int(chunks), func(c int) {
+			part := x[uint32(c)*chunkLen : uint32(c+1)*chunkLen]
+			for i := range part {
+				part[i] = part[i].Mul(nInv)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package ntt
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestParallelNTTMatchesNTT(t *testing.T) {
+	rng := rand.New(rand.NewSource(556))
+	for _, n := range []int{0, 1, 16, parallelThreshold, 4 * parallelThreshold} {
+		x := make([]field.Element, n)
+		for i := range x {
+			x[i] = field.New(rng.Uint64())
+		}
+		want := append([]field.Element(nil), x...)
+		NTT(want)
+
+		// Worker counts that are not powers of 2, and more workers than butterflies
+		for _, workers := range []int{0, 1, 2, 3, 4, 7, 8, 1 << 16} {
+			got := append([]field.Element(nil), x...)
+			ParallelNTT(got, workers)
+			for i := range got {
+				if !got[i].Equal(want[i]) {
+					t.Fatalf("n=%d workers=%d: ParallelNTT differs from NTT at %d", n, workers, i)
+				}
+			}
+
+			ParallelINTT(got, workers)
+			for i := range got {
+				if !got[i].Equal(x[i]) {
+					t.Fatalf("n=%d workers=%d: ParallelINTT did not undo ParallelNTT at %d", n, workers, i)
+				}
+			}
+		}
+	}
+}
+
+func TestParallelNTTPanicsOnNonPowerOfTwo(t *testing.T) {
+	for name, fn := range map[string]func([]field.Element, int){"ParallelNTT": ParallelNTT, "ParallelINTT": ParallelINTT} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s of length 3 did not panic", name)
+				}
+			}()
+			fn(make([]field.Element, 3), 4)
+		}()
+	}
+}
+
+func BenchmarkParallelNTT262144(b *testing.B) {
+	data := benchmarkButterflyInput(1 << 18)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ParallelNTT(data, 0)
+	}
+}
+
+func BenchmarkNTT262144(b *testing.B) {
+	data := benchmarkButterflyInput(1 << 18)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NTT(data)
+	}
+}
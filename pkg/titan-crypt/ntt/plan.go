@@ -0,0 +1,58 @@
+package ntt
+
+import (
+	"fmt"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// Plan holds the forward and inverse root tables for transforms of one fixed size.
+// Reusing a Plan skips the root table lookups NTT and INTT perform on every call.
+// A Plan is immutable and safe for concurrent use.
+type Plan struct {
+	n       int
+	forward []field.Element
+	inverse []field.Element
+}
+
+// NewPlan prepares transforms of length n.
+// Returns an error if n is not a power of 2 or exceeds 2^31.
+func NewPlan(n int) (*Plan, error) {
+	if !IsPowerOfTwo(n) {
+		return nil, fmt.Errorf("NTT requires power-of-2 length, got %d", n)
+	}
+	if n > (1 << 31) {
+		return nil, fmt.Errorf("NTT length too large: %d", n)
+	}
+
+	return &Plan{
+		n:       n,
+		forward: getRoots(uint32(n), false),
+		inverse: getRoots(uint32(n), true),
+	}, nil
+}
+
+// Size returns the transform length.
+func (p *Plan) Size() int {
+	return p.n
+}
+
+// Forward performs an in-place NTT, identical to NTT(x).
+// Panics if len(x) != p.Size().
+func (p *Plan) Forward(x []field.Element) {
+	p.checkLength(x)
+	transform(x, p.forward, false)
+}
+
+// Inverse performs an in-place inverse NTT, identical to INTT(x).
+// Panics if len(x) != p.Size().
+func (p *Plan) Inverse(x []field.Element) {
+	p.checkLength(x)
+	transform(x, p.inverse, true)
+}
+
+func (p *Plan) checkLength(x []field.Element) {
+	if len(x) != p.n {
+		panic(fmt.Sprintf("plan for length %d used with length %d", p.n, len(x)))
+	}
+}
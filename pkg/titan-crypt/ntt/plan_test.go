@@ -0,0 +1,61 @@
+package ntt
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestPlanMatchesNTT(t *testing.T) {
+	rng := rand.New(rand.NewSource(401))
+
+	for _, size := range []int{1, 2, 4, 64, 1024} {
+		plan, err := NewPlan(size)
+		if err != nil {
+			t.Fatalf("NewPlan(%d) failed: %v", size, err)
+		}
+		if plan.Size() != size {
+			t.Errorf("Size() = %d, want %d", plan.Size(), size)
+		}
+
+		values := randomElements(rng, size)
+
+		want := append([]field.Element(nil), values...)
+		NTT(want)
+		got := append([]field.Element(nil), values...)
+		plan.Forward(got)
+		for i := range want {
+			if !got[i].Equal(want[i]) {
+				t.Fatalf("size %d: Forward[%d] = %v, want %v", size, i, got[i], want[i])
+			}
+		}
+
+		INTT(want)
+		plan.Inverse(got)
+		for i := range want {
+			if !got[i].Equal(want[i]) {
+				t.Fatalf("size %d: Inverse[%d] = %v, want %v", size, i, got[i], want[i])
+			}
+			if !got[i].Equal(values[i]) {
+				t.Fatalf("size %d: round trip failed at %d", size, i)
+			}
+		}
+	}
+}
+
+func TestPlanErrors(t *testing.T) {
+	for _, size := range []int{0, 3, 12, -4} {
+		if _, err := NewPlan(size); err == nil {
+			t.Errorf("NewPlan(%d) should fail", size)
+		}
+	}
+
+	plan, _ := NewPlan(8)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for mismatched length")
+		}
+	}()
+	plan.Forward(make([]field.Element, 4))
+}
@@ -0,0 +1,99 @@
+package ntt
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// TransformPool runs forward NTTs on a fixed number of worker goroutines.
+// The job queue holds at most one pending job per worker; once it is full,
+// Submit blocks until a worker frees up, which bounds memory and goroutines
+// no matter how many transforms callers submit.
+type TransformPool struct {
+	jobs chan transformJob
+	wg   sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+type transformJob struct {
+	data   []field.Element
+	result chan<- []field.Element
+}
+
+// NewTransformPool starts a pool with the given number of workers.
+// If workers <= 0, runtime.GOMAXPROCS(0) workers are used.
+func NewTransformPool(workers int) *TransformPool {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	p := &TransformPool{
+		jobs: make(chan transformJob, workers),
+	}
+
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.worker()
+	}
+
+	return p
+}
+
+func (p *TransformPool) worker() {
+	defer p.wg.Done()
+	for job := range p.jobs {
+		NTT(job.data)
+		job.result <- job.data
+	}
+}
+
+// Submit queues the NTT of data and returns a channel that receives the
+// transformed values exactly once. data is copied, so the caller may reuse it
+// immediately. Submit blocks while the queue is full.
+//
+// Submitting to a closed pool returns a channel that is closed without
+// delivering a result.
+//
+// Panics in the caller's goroutine if len(data) is not a power of 2.
+func (p *TransformPool) Submit(data []field.Element) <-chan []field.Element {
+	if len(data) != 0 && !IsPowerOfTwo(len(data)) {
+		panic(fmt.Sprintf("NTT requires power-of-2 length, got %d", len(data)))
+	}
+
+	result := make(chan []field.Element, 1)
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		close(result)
+		return result
+	}
+
+	p.jobs <- transformJob{
+		data:   append([]field.Element(nil), data...),
+		result: result,
+	}
+	return result
+}
+
+// Close stops accepting jobs, waits for all queued transforms to finish and
+// stops the workers. Results of jobs submitted before Close are still delivered.
+// Calling Close more than once is a no-op.
+func (p *TransformPool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.jobs)
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
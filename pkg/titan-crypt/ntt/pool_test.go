@@ -0,0 +1,97 @@
+package ntt
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestTransformPool(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	const jobs = 200
+	rng := rand.New(rand.NewSource(397))
+	inputs := make([][]field.Element, jobs)
+	for i := range inputs {
+		inputs[i] = randomElements(rng, 1<<(i%8))
+	}
+
+	pool := NewTransformPool(3)
+
+	// Submit from many goroutines at once; far more jobs than workers
+	var wg sync.WaitGroup
+	for i := range inputs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			want := append([]field.Element(nil), inputs[i]...)
+			NTT(want)
+
+			got, ok := <-pool.Submit(inputs[i])
+			if !ok {
+				t.Errorf("job %d: result channel closed without a value", i)
+				return
+			}
+			for k := range want {
+				if !got[k].Equal(want[k]) {
+					t.Errorf("job %d: result[%d] = %v, want %v", i, k, got[k], want[k])
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	pool.Close()
+	pool.Close()
+
+	// Every worker must have exited
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("goroutine leak: %d before, %d after Close", before, after)
+	}
+}
+
+func TestTransformPoolDoesNotModifyInput(t *testing.T) {
+	pool := NewTransformPool(1)
+	defer pool.Close()
+
+	input := []field.Element{field.New(1), field.New(2), field.New(3), field.New(4)}
+	original := append([]field.Element(nil), input...)
+	<-pool.Submit(input)
+
+	for i := range input {
+		if !input[i].Equal(original[i]) {
+			t.Fatalf("Submit modified its input at %d", i)
+		}
+	}
+}
+
+func TestTransformPoolSubmitAfterClose(t *testing.T) {
+	pool := NewTransformPool(2)
+	pool.Close()
+
+	if _, ok := <-pool.Submit([]field.Element{field.One, field.One}); ok {
+		t.Error("Submit after Close delivered a result")
+	}
+}
+
+func TestTransformPoolPanicsOnInvalidLength(t *testing.T) {
+	pool := NewTransformPool(1)
+	defer pool.Close()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for non-power-of-2 length")
+		}
+	}()
+	pool.Submit(make([]field.Element, 3))
+}
@@ -0,0 +1,41 @@
+package polynomial
+
+import (
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// BatchCombine returns the random linear combination Σ alpha^i · polys[i].
+// The powers of alpha are accumulated incrementally, so the cost is one
+// multiplication per coefficient plus one per polynomial.
+//
+// For any x: BatchCombine(polys, alpha).Evaluate(x) == Σ alpha^i · polys[i].Evaluate(x)
+func BatchCombine(polys []*Polynomial, alpha field.Element) *Polynomial {
+	maxLen := 0
+	for _, p := range polys {
+		if len(p.coefficients) > maxLen {
+			maxLen = len(p.coefficients)
+		}
+	}
+
+	coeffs := make([]field.Element, maxLen)
+	alphaPower := field.One
+	for _, p := range polys {
+		for j, c := range p.coefficients {
+			coeffs[j] = coeffs[j].Add(c.Mul(alphaPower))
+		}
+		alphaPower = alphaPower.Mul(alpha)
+	}
+
+	return New(coeffs)
+}
+
+// AllWithinDegree checks DegreeWithin(bound) for every polynomial. It returns
+// (-1, true) if all pass, and otherwise the index of the first offender and false.
+func AllWithinDegree(polys []*Polynomial, bound int) (int, bool) {
+	for i, p := range polys {
+		if !p.DegreeWithin(bound) {
+			return i, false
+		}
+	}
+	return -1, true
+}
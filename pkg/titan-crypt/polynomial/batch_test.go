@@ -0,0 +1,93 @@
+package polynomial
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func randomPolynomial(rng *rand.Rand, degree int) *Polynomial {
+	coeffs := make([]field.Element, degree+1)
+	for i := range coeffs {
+		coeffs[i] = field.New(rng.Uint64())
+	}
+	return New(coeffs)
+}
+
+func TestBatchCombine(t *testing.T) {
+	rng := rand.New(rand.NewSource(382))
+
+	polys := []*Polynomial{
+		randomPolynomial(rng, 3),
+		randomPolynomial(rng, 10),
+		Zero(),
+		randomPolynomial(rng, 0),
+		randomPolynomial(rng, 7),
+	}
+	alpha := field.New(rng.Uint64())
+	combined := BatchCombine(polys, alpha)
+
+	for i := 0; i < 20; i++ {
+		x := field.New(rng.Uint64())
+
+		want := field.Zero
+		weight := field.One
+		for _, p := range polys {
+			want = want.Add(weight.Mul(p.Evaluate(x)))
+			weight = weight.Mul(alpha)
+		}
+
+		if got := combined.Evaluate(x); !got.Equal(want) {
+			t.Errorf("BatchCombine(...)(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestBatchCombineEdgeCases(t *testing.T) {
+	if !BatchCombine(nil, field.New(5)).IsZero() {
+		t.Error("combination of no polynomials should be zero")
+	}
+
+	p := New([]field.Element{field.New(1), field.New(2), field.New(3)})
+	if !BatchCombine([]*Polynomial{p}, field.New(5)).Equal(p) {
+		t.Error("combination of a single polynomial should be the polynomial itself")
+	}
+
+	// alpha = 0 keeps only the first polynomial
+	q := New([]field.Element{field.New(4), field.New(5)})
+	if !BatchCombine([]*Polynomial{p, q}, field.Zero).Equal(p) {
+		t.Error("combination with alpha = 0 should equal the first polynomial")
+	}
+}
+
+func TestDegreeWithin(t *testing.T) {
+	const bound = 4
+	atBound := New([]field.Element{field.One, field.Zero, field.Zero, field.Zero, field.New(7)})
+	over := New([]field.Element{field.One, field.Zero, field.Zero, field.Zero, field.Zero, field.New(7)})
+	// Trailing zero coefficients do not count towards the degree.
+	padded := New([]field.Element{field.One, field.New(2), field.Zero, field.Zero, field.Zero, field.Zero, field.Zero})
+
+	if !atBound.DegreeWithin(bound) {
+		t.Error("polynomial of degree exactly the bound should be within it")
+	}
+	if over.DegreeWithin(bound) {
+		t.Error("polynomial of degree bound+1 should not be within the bound")
+	}
+	if !padded.DegreeWithin(1) {
+		t.Error("trailing zeros should not affect DegreeWithin")
+	}
+	if !Zero().DegreeWithin(-1) || Zero().DegreeWithin(-2) {
+		t.Error("zero polynomial should be within exactly the bounds >= -1")
+	}
+
+	if i, ok := AllWithinDegree([]*Polynomial{Zero(), atBound, padded}, bound); !ok || i != -1 {
+		t.Errorf("AllWithinDegree = (%d, %v), want (-1, true)", i, ok)
+	}
+	if i, ok := AllWithinDegree([]*Polynomial{atBound, over, over}, bound); ok || i != 1 {
+		t.Errorf("AllWithinDegree = (%d, %v), want (1, false)", i, ok)
+	}
+	if i, ok := AllWithinDegree(nil, bound); !ok || i != -1 {
+		t.Errorf("AllWithinDegree(nil) = (%d, %v), want (-1, true)", i, ok)
+	}
+}
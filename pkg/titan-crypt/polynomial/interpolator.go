@@ -0,0 +1,60 @@
+package polynomial
+
+import (
+	"fmt"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/ntt"
+)
+
+// Interpolator converts between coefficient and evaluation form on the fixed
+// domain of powers of a primitive root of unity of some order, keeping the
+// transform tables for that order in an ntt.Plan. Since NTT and INTT already
+// cache their tables per length, the saving over InterpolateNTT is only the
+// per-call table lookup; see BenchmarkInterpolatorReuse.
+// An Interpolator is safe for concurrent use.
+type Interpolator struct {
+	plan *ntt.Plan
+}
+
+// NewInterpolator prepares interpolation and evaluation on the domain of the given order.
+// Returns an error if order is not a power of 2 supported by the NTT.
+func NewInterpolator(order uint64) (*Interpolator, error) {
+	if order > 1<<31 {
+		return nil, fmt.Errorf("interpolation domain too large: %d", order)
+	}
+	plan, err := ntt.NewPlan(int(order))
+	if err != nil {
+		return nil, err
+	}
+	return &Interpolator{plan: plan}, nil
+}
+
+// Order returns the size of the domain.
+func (in *Interpolator) Order() uint64 {
+	return uint64(in.plan.Size())
+}
+
+// Interpolate returns the polynomial of degree < Order() whose evaluation at ωⁱ is values[i].
+// It matches InterpolateNTT(values).
+//
+// Panics if len(values) != Order().
+func (in *Interpolator) Interpolate(values []field.Element) *Polynomial {
+	coeffs := make([]field.Element, len(values))
+	copy(coeffs, values)
+	in.plan.Inverse(coeffs)
+	return New(coeffs)
+}
+
+// Evaluate returns the evaluations of p at ω⁰, …, ω^(Order()-1).
+// It matches p.EvaluateNTT(Order()) for polynomials of degree < Order(); higher
+// coefficients are folded in using ω^Order() = 1.
+func (in *Interpolator) Evaluate(p *Polynomial) []field.Element {
+	n := in.plan.Size()
+	evals := make([]field.Element, n)
+	for i, c := range p.coefficients {
+		evals[i%n] = evals[i%n].Add(c)
+	}
+	in.plan.Forward(evals)
+	return evals
+}
@@ -0,0 +1,100 @@
+package polynomial
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestInterpolatorMatchesStandalone(t *testing.T) {
+	rng := rand.New(rand.NewSource(401))
+
+	for _, order := range []uint64{1, 2, 16, 256} {
+		in, err := NewInterpolator(order)
+		if err != nil {
+			t.Fatalf("NewInterpolator(%d) failed: %v", order, err)
+		}
+		if in.Order() != order {
+			t.Errorf("Order() = %d, want %d", in.Order(), order)
+		}
+
+		for trial := 0; trial < 5; trial++ {
+			values := make([]field.Element, order)
+			for i := range values {
+				values[i] = field.New(rng.Uint64())
+			}
+
+			got := in.Interpolate(values)
+			want := InterpolateNTT(values)
+			if !got.Equal(want) {
+				t.Fatalf("order %d: Interpolate differs from InterpolateNTT", order)
+			}
+
+			evals := in.Evaluate(got)
+			standalone := want.EvaluateNTT(int(order))
+			for i := range values {
+				if !evals[i].Equal(standalone[i]) || !evals[i].Equal(values[i]) {
+					t.Fatalf("order %d: Evaluate[%d] = %v, want %v", order, i, evals[i], values[i])
+				}
+			}
+		}
+	}
+}
+
+func TestInterpolatorEvaluateHighDegree(t *testing.T) {
+	// Coefficients beyond the domain size wrap around since ω^order = 1
+	rng := rand.New(rand.NewSource(401))
+	const order = 8
+	in, _ := NewInterpolator(order)
+	p := randomPolynomial(rng, 3*order+2)
+
+	root, _ := field.GetPrimitiveRoot(order)
+	evals := in.Evaluate(p)
+	for i := range evals {
+		if want := p.Evaluate(root.ModPow(uint64(i))); !evals[i].Equal(want) {
+			t.Errorf("Evaluate[%d] = %v, want %v", i, evals[i], want)
+		}
+	}
+}
+
+func TestInterpolatorErrors(t *testing.T) {
+	for _, order := range []uint64{0, 3, 1 << 40} {
+		if _, err := NewInterpolator(order); err == nil {
+			t.Errorf("NewInterpolator(%d) should fail", order)
+		}
+	}
+}
+
+func benchmarkInterpolationValues() [][]field.Element {
+	rng := rand.New(rand.NewSource(401))
+	vectors := make([][]field.Element, 100)
+	for i := range vectors {
+		vectors[i] = make([]field.Element, 1<<16)
+		for j := range vectors[i] {
+			vectors[i][j] = field.New(rng.Uint64())
+		}
+	}
+	return vectors
+}
+
+func BenchmarkInterpolatorReuse(b *testing.B) {
+	vectors := benchmarkInterpolationValues()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		in, _ := NewInterpolator(1 << 16)
+		for _, values := range vectors {
+			in.Interpolate(values)
+		}
+	}
+}
+
+func BenchmarkInterpolatorFresh(b *testing.B) {
+	vectors := benchmarkInterpolationValues()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, values := range vectors {
+			InterpolateNTT(values)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package polynomial
+
+import (
+	"runtime"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/internal/parallel"
+)
+
+// EvalMatrix evaluates every polynomial at every point: result[i][j] = polys[i].Evaluate(points[j]).
+//
+// The powers points[j]^k are computed once, up to the largest degree, and shared by
+// all polynomials, so each evaluation is a plain dot product. Polynomials are spread
+// over runtime.GOMAXPROCS(0) goroutines.
+func EvalMatrix(polys []*Polynomial, points []field.Element) [][]field.Element {
+	maxLen := 0
+	for _, p := range polys {
+		if len(p.coefficients) > maxLen {
+			maxLen = len(p.coefficients)
+		}
+	}
+
+	// powers[j][k] = points[j]^k
+	powers := make([][]field.Element, len(points))
+	for j, x := range points {
+		row := make([]field.Element, maxLen)
+		power := field.One
+		for k := range row {
+			row[k] = power
+			power = power.Mul(x)
+		}
+		powers[j] = row
+	}
+
+	result := make([][]field.Element, len(polys))
+	evalRow := func(i int) {
+		coeffs := polys[i].coefficients
+		row := make([]field.Element, len(points))
+		for j := range points {
+			acc := field.Zero
+			for k, c := range coeffs {
+				acc = acc.Add(c.Mul(powers[j][k]))
+			}
+			row[j] = acc
+		}
+		result[i] = row
+	}
+
+	workers := min(runtime.GOMAXPROCS(0), len(polys))
+	parallel.Parts(workers, func(w int) {
+		for i := w; i < len(polys); i += workers {
+			evalRow(i)
+		}
+	})
+
+	return result
+}
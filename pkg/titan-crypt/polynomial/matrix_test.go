@@ -0,0 +1,75 @@
+package polynomial
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestEvalMatrix(t *testing.T) {
+	rng := rand.New(rand.NewSource(399))
+
+	polys := []*Polynomial{Zero(), One(), X()}
+	for degree := 0; degree < 20; degree++ {
+		polys = append(polys, randomPolynomial(rng, degree))
+	}
+	points := []field.Element{field.Zero, field.One, field.Max, field.New(rng.Uint64()), field.New(rng.Uint64())}
+
+	result := EvalMatrix(polys, points)
+	if len(result) != len(polys) {
+		t.Fatalf("got %d rows, want %d", len(result), len(polys))
+	}
+	for i, p := range polys {
+		if len(result[i]) != len(points) {
+			t.Fatalf("row %d has %d entries, want %d", i, len(result[i]), len(points))
+		}
+		for j, x := range points {
+			if want := p.Evaluate(x); !result[i][j].Equal(want) {
+				t.Errorf("result[%d][%d] = %v, want %v", i, j, result[i][j], want)
+			}
+		}
+	}
+}
+
+func TestEvalMatrixEmpty(t *testing.T) {
+	if got := EvalMatrix(nil, []field.Element{field.One}); len(got) != 0 {
+		t.Errorf("EvalMatrix with no polynomials = %v", got)
+	}
+
+	got := EvalMatrix([]*Polynomial{One(), X()}, nil)
+	if len(got) != 2 || len(got[0]) != 0 || len(got[1]) != 0 {
+		t.Errorf("EvalMatrix with no points = %v", got)
+	}
+}
+
+func benchmarkEvalMatrixInputs() ([]*Polynomial, []field.Element) {
+	rng := rand.New(rand.NewSource(399))
+	polys := make([]*Polynomial, 64)
+	for i := range polys {
+		polys[i] = randomPolynomial(rng, 1<<12-1)
+	}
+	points := make([]field.Element, 4)
+	for j := range points {
+		points[j] = field.New(rng.Uint64())
+	}
+	return polys, points
+}
+
+func BenchmarkEvalMatrix(b *testing.B) {
+	polys, points := benchmarkEvalMatrixInputs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EvalMatrix(polys, points)
+	}
+}
+
+func BenchmarkEvalMatrixSequentialEvaluate(b *testing.B) {
+	polys, points := benchmarkEvalMatrixInputs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, p := range polys {
+			p.BatchEvaluate(points)
+		}
+	}
+}
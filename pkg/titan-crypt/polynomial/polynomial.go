@@ -70,6 +70,12 @@ func (p *Polynomial) Degree() int {
 	return deg
 }
 
+// DegreeWithin returns true if Degree() <= bound. The zero polynomial has degree -1
+// and is within every bound >= -1.
+func (p *Polynomial) DegreeWithin(bound int) bool {
+	return p.Degree() <= bound
+}
+
 // Coefficients returns the polynomial's coefficients in order of increasing degree.
 // The leading coefficient is guaranteed to be non-zero (except for the zero polynomial).
 func (p *Polynomial) Coefficients() []field.Element {
@@ -271,6 +277,12 @@ func (p *Polynomial) FormalDerivative() *Polynomial {
 	return New(coeffs)
 }
 
+// Derivative returns the formal derivative of the polynomial.
+// It is an alias for FormalDerivative; the derivative of a constant is the zero polynomial.
+func (p *Polynomial) Derivative() *Polynomial {
+	return p.FormalDerivative()
+}
+
 // Shift shifts the polynomial: returns p(x - offset).
 func (p *Polynomial) Shift(offset field.Element) *Polynomial {
 	if p.IsZero() {
@@ -107,6 +107,27 @@ func InterpolateNTT(values []field.Element) *Polynomial {
 	return New(coeffs)
 }
 
+// InterpolateTrimmed is InterpolateNTT for evaluations of a low-degree polynomial:
+// it runs the INTT and drops the trailing zero coefficients in place, so Degree()
+// is exact and no second copy of the coefficients is made.
+//
+// Panics if len(evals) is not a power of 2.
+func InterpolateTrimmed(evals []field.Element) *Polynomial {
+	if len(evals) == 0 {
+		return Zero()
+	}
+
+	if !ntt.IsPowerOfTwo(len(evals)) {
+		panic("number of values must be a power of 2")
+	}
+
+	coeffs := make([]field.Element, len(evals))
+	copy(coeffs, evals)
+	ntt.INTT(coeffs)
+
+	return &Polynomial{coefficients: field.TrimTrailingZeros(coeffs)}
+}
+
 // DivideNTT divides two polynomials using NTT-based multiplication.
 // Returns (quotient, remainder) such that p = quotient * other + remainder.
 //
@@ -1,9 +1,11 @@
 package polynomial
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/ntt"
 )
 
 // TestMulNTT tests NTT-based polynomial multiplication
@@ -252,3 +254,138 @@ func BenchmarkEvaluateNTT(b *testing.B) {
 		_ = p.EvaluateNTT(128)
 	}
 }
+
+func TestInterpolateTrimmed(t *testing.T) {
+	rng := rand.New(rand.NewSource(412))
+
+	for _, domainSize := range []int{1, 4, 64, 512} {
+		for _, degree := range []int{-1, 0, 1, 3, domainSize/4 - 1, domainSize - 1} {
+			if degree >= domainSize {
+				continue
+			}
+
+			p := Zero()
+			if degree >= 0 {
+				p = randomPolynomial(rng, degree)
+			}
+
+			got := InterpolateTrimmed(p.EvaluateNTT(domainSize))
+			if !got.Equal(p) {
+				t.Fatalf("size %d, degree %d: InterpolateTrimmed(NTT(p)) != p", domainSize, degree)
+			}
+			if got.Degree() != p.Degree() {
+				t.Errorf("size %d: Degree() = %d, want %d", domainSize, got.Degree(), p.Degree())
+			}
+			if len(got.coefficients) != p.Degree()+1 {
+				t.Errorf("size %d, degree %d: %d stored coefficients, want %d",
+					domainSize, degree, len(got.coefficients), p.Degree()+1)
+			}
+		}
+	}
+
+	if !InterpolateTrimmed(nil).IsZero() {
+		t.Error("InterpolateTrimmed(nil) should be the zero polynomial")
+	}
+}
+
+func TestRestrictToSubgroup(t *testing.T) {
+	rng := rand.New(rand.NewSource(419))
+
+	for _, n := range []int{1, 2, 8, 64} {
+		evals := make([]field.Element, 2*n)
+		for i := range evals {
+			evals[i] = field.New(rng.Uint64())
+		}
+
+		restricted := field.RestrictToSubgroup(evals)
+		if len(restricted) != n {
+			t.Fatalf("restriction of %d evaluations has length %d, want %d", 2*n, len(restricted), n)
+		}
+
+		poly := InterpolateNTT(evals)
+		omega := field.PrimitiveRootOfUnity(uint64(n))
+		point := field.One
+		for i, got := range restricted {
+			if want := poly.Evaluate(point); !got.Equal(want) {
+				t.Fatalf("n=%d: restricted[%d] = %v, want %v", n, i, got, want)
+			}
+			point = point.Mul(omega)
+		}
+
+		// For a polynomial of degree < n the restriction is its NTT on the subgroup.
+		low := New(evals[:n])
+		full := field.RestrictToSubgroup(low.EvaluateNTT(2 * n))
+		want := low.EvaluateNTT(n)
+		for i := range want {
+			if !full[i].Equal(want[i]) {
+				t.Fatalf("n=%d: restriction of EvaluateNTT(2n) differs from EvaluateNTT(n) at %d", n, i)
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for odd length")
+		}
+	}()
+	field.RestrictToSubgroup(make([]field.Element, 3))
+}
+
+func TestConvolverMatchesMulNTT(t *testing.T) {
+	rng := rand.New(rand.NewSource(393))
+
+	for _, kernelDegree := range []int{0, 1, 4, 15, 32} {
+		kernel := randomPolynomial(rng, kernelDegree)
+		c, err := ntt.NewConvolver(kernel.Coefficients())
+		if err != nil {
+			t.Fatalf("NewConvolver failed: %v", err)
+		}
+
+		// Inputs shorter than, equal to, and longer than the kernel
+		for _, xDegree := range []int{0, 2, kernelDegree, 2*kernelDegree + 6, 99} {
+			x := randomPolynomial(rng, xDegree)
+			got := c.Convolve(x.Coefficients())
+			if want := len(kernel.Coefficients()) + len(x.Coefficients()) - 1; len(got) != want {
+				t.Fatalf("kernel degree %d, x degree %d: got length %d, want %d", kernelDegree, xDegree, len(got), want)
+			}
+			if want := kernel.MulNTT(x); !New(got).Equal(want) {
+				t.Fatalf("kernel degree %d, x degree %d: Convolve differs from MulNTT", kernelDegree, xDegree)
+			}
+		}
+	}
+}
+
+const benchmarkConvolutions = 100
+
+func benchmarkConvolutionInputs() (*Polynomial, []*Polynomial) {
+	rng := rand.New(rand.NewSource(393))
+	kernel := randomPolynomial(rng, 1023)
+	inputs := make([]*Polynomial, benchmarkConvolutions)
+	for i := range inputs {
+		inputs[i] = randomPolynomial(rng, 1023)
+	}
+	return kernel, inputs
+}
+
+func BenchmarkConvolverReuse(b *testing.B) {
+	kernel, inputs := benchmarkConvolutionInputs()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c, _ := ntt.NewConvolver(kernel.Coefficients())
+		for _, x := range inputs {
+			c.Convolve(x.Coefficients())
+		}
+	}
+}
+
+func BenchmarkConvolverMulNTT(b *testing.B) {
+	kernel, inputs := benchmarkConvolutionInputs()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, x := range inputs {
+			kernel.MulNTT(x)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package polynomial
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
@@ -175,6 +176,44 @@ func TestPolynomialFormalDerivative(t *testing.T) {
 	}
 }
 
+func TestPolynomialDerivative(t *testing.T) {
+	// p(x) = 7 + 5x + 0x^2 + 2x^3 + x^4
+	// p'(x) = 5 + 0x + 6x^2 + 4x^3
+	p := New([]field.Element{field.New(7), field.New(5), field.Zero, field.New(2), field.One})
+	expected := New([]field.Element{field.New(5), field.Zero, field.New(6), field.New(4)})
+	if !p.Derivative().Equal(expected) {
+		t.Errorf("Derivative() = %v, want %v", p.Derivative(), expected)
+	}
+
+	// Coefficients that wrap around P: d/dx (P-1)x^2 = 2(P-1)x = (P-2)x
+	q := New([]field.Element{field.Zero, field.Zero, field.Max})
+	if !q.Derivative().Equal(New([]field.Element{field.Zero, field.New(field.P - 2)})) {
+		t.Errorf("Derivative() of (P-1)x^2 = %v", q.Derivative())
+	}
+
+	for _, c := range []*Polynomial{Zero(), One(), New([]field.Element{field.New(42)})} {
+		if !c.Derivative().IsZero() {
+			t.Errorf("derivative of constant %v should be zero, got %v", c, c.Derivative())
+		}
+	}
+}
+
+func TestPolynomialDerivativeProductRule(t *testing.T) {
+	rng := rand.New(rand.NewSource(387))
+
+	for i := 0; i < 20; i++ {
+		f := randomPolynomial(rng, rng.Intn(8))
+		g := randomPolynomial(rng, rng.Intn(8))
+
+		// (f·g)' == f'·g + f·g'
+		lhs := f.Mul(g).Derivative()
+		rhs := f.Derivative().Mul(g).Add(f.Mul(g.Derivative()))
+		if !lhs.Equal(rhs) {
+			t.Errorf("product rule failed for f = %v, g = %v", f, g)
+		}
+	}
+}
+
 func TestPolynomialMonic(t *testing.T) {
 	// 2 + 4x + 6x^2 -> (1/6)(2 + 4x + 6x^2)
 	p := New([]field.Element{field.New(2), field.New(4), field.New(6)})
@@ -0,0 +1,35 @@
+package xfield
+
+import (
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/polynomial"
+)
+
+// BatchCombine returns the random linear combination Σ alpha^i · polys[i] of
+// base field polynomials with an extension field challenge alpha.
+// The powers of alpha are accumulated incrementally and each base coefficient
+// scales the current power directly, without being lifted first.
+//
+// For any x: BatchCombine(polys, alpha).Evaluate(x) == Σ alpha^i · polys[i](x)
+func BatchCombine(polys []*polynomial.Polynomial, alpha XFieldElement) *Polynomial {
+	maxLen := 0
+	for _, p := range polys {
+		if n := len(p.Coefficients()); n > maxLen {
+			maxLen = n
+		}
+	}
+
+	coeffs := make([]XFieldElement, maxLen)
+	for j := range coeffs {
+		coeffs[j] = Zero
+	}
+
+	alphaPower := One
+	for _, p := range polys {
+		for j, c := range p.Coefficients() {
+			coeffs[j] = coeffs[j].Add(alphaPower.MulConst(c))
+		}
+		alphaPower = alphaPower.Mul(alpha)
+	}
+
+	return NewPolynomial(coeffs)
+}
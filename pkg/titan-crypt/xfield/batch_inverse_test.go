@@ -0,0 +1,67 @@
+package xfield
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBatchInverse(t *testing.T) {
+	rng := rand.New(rand.NewSource(539))
+	values := make([]XFieldElement, 500)
+	for i := range values {
+		if i%13 != 0 {
+			values[i] = randomXFieldElement(rng)
+		}
+	}
+
+	got := BatchInverse(values)
+	for i, v := range values {
+		if !got[i].Equal(v.InverseOrZero()) {
+			t.Fatalf("BatchInverse[%d] = %v, want %v", i, got[i], v.InverseOrZero())
+		}
+	}
+
+	BatchInverseInPlace(values)
+	for i := range values {
+		if !values[i].Equal(got[i]) {
+			t.Fatalf("BatchInverseInPlace[%d] = %v, want %v", i, values[i], got[i])
+		}
+	}
+
+	if len(BatchInverse(nil)) != 0 {
+		t.Error("BatchInverse(nil) should be empty")
+	}
+	allZero := []XFieldElement{Zero, Zero}
+	BatchInverseInPlace(allZero)
+	if !allZero[0].IsZero() || !allZero[1].IsZero() {
+		t.Errorf("all-zero input changed to %v", allZero)
+	}
+}
+
+func benchmarkInverseInputs() []XFieldElement {
+	rng := rand.New(rand.NewSource(539))
+	values := make([]XFieldElement, 4096)
+	for i := range values {
+		values[i] = randomXFieldElement(rng)
+	}
+	return values
+}
+
+func BenchmarkBatchInverse4096(b *testing.B) {
+	values := benchmarkInverseInputs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BatchInverse(values)
+	}
+}
+
+func BenchmarkInverseLoop4096(b *testing.B) {
+	values := benchmarkInverseInputs()
+	result := make([]XFieldElement, len(values))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, v := range values {
+			result[j] = v.Inverse()
+		}
+	}
+}
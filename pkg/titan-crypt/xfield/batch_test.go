@@ -0,0 +1,62 @@
+package xfield
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/polynomial"
+)
+
+func randomXFieldElement(rng *rand.Rand) XFieldElement {
+	return New([3]field.Element{
+		field.New(rng.Uint64()),
+		field.New(rng.Uint64()),
+		field.New(rng.Uint64()),
+	})
+}
+
+func TestBatchCombine(t *testing.T) {
+	rng := rand.New(rand.NewSource(382))
+
+	polys := []*polynomial.Polynomial{
+		randomBasePolynomial(rng, 5),
+		randomBasePolynomial(rng, 2),
+		polynomial.Zero(),
+		randomBasePolynomial(rng, 12),
+	}
+	alpha := randomXFieldElement(rng)
+	combined := BatchCombine(polys, alpha)
+
+	for i := 0; i < 20; i++ {
+		x := randomXFieldElement(rng)
+
+		want := Zero
+		weight := One
+		for _, p := range polys {
+			want = want.Add(weight.Mul(LiftPolynomial(p).Evaluate(x)))
+			weight = weight.Mul(alpha)
+		}
+
+		if got := combined.Evaluate(x); !got.Equal(want) {
+			t.Errorf("BatchCombine(...)(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestBatchCombineMatchesBaseField(t *testing.T) {
+	// With a lifted base field challenge the result is the lift of the base field combination
+	rng := rand.New(rand.NewSource(383))
+
+	polys := []*polynomial.Polynomial{
+		randomBasePolynomial(rng, 4),
+		randomBasePolynomial(rng, 6),
+	}
+	alpha := field.New(rng.Uint64())
+
+	got := BatchCombine(polys, NewConst(alpha))
+	want := LiftPolynomial(polynomial.BatchCombine(polys, alpha))
+	if !got.Equal(want) {
+		t.Error("BatchCombine with a base field challenge differs from the lifted base field combination")
+	}
+}
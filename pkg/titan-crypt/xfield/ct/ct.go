@@ -0,0 +1,129 @@
+// Package ct provides constant-time arithmetic on extension field elements for
+// use with secret data. None of its functions branch on, or index memory by,
+// the values of their operands. It builds on field/ct, whose package comment
+// lists the variable-time base field methods.
+//
+// The methods of xfield.XFieldElement are written for speed, and all of the
+// following are variable-time in their operands:
+//   - Add, AddConst, Neg, Mul, Frobenius, FrobeniusPow, Norm and Trace are
+//     built on field.Element.Add or Neg, which branch on the result.
+//     Sub, SubConst and MulConst are branch-free.
+//   - Inverse branches on zero and on whether x lies in F_p, and otherwise runs
+//     a polynomial extended GCD whose length depends on x. InverseOrZero, Div,
+//     DivConst and BatchInverse inherit this and also skip zeros.
+//   - Pow, PowSigned and PowBig branch on the bits of the exponent, and PowBig
+//     reduces it with math/big.
+//   - Sqrt branches on whether the norm is a square and runs Tonelli–Shanks.
+//   - Equal, IsZero, IsOne, IsInBaseField and FrobeniusFixed return a bool that
+//     callers then branch on.
+//   - String, ElementFromString and the encoding methods are variable-time.
+package ct
+
+import (
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	fieldct "github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field/ct"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/xfield"
+)
+
+// Images of the basis elements x and x² under φ and φ². They are public
+// constants, so computing them with the variable-time methods is fine.
+var (
+	frobeniusX    = xfield.New([xfield.ExtensionDegree]field.Element{field.Zero, field.One, field.Zero}).Frobenius()
+	frobeniusX2   = frobeniusX.Mul(frobeniusX)
+	frobeniusSqX  = frobeniusX.Frobenius()
+	frobeniusSqX2 = frobeniusSqX.Mul(frobeniusSqX)
+)
+
+// Add returns a + b.
+func Add(a, b xfield.XFieldElement) xfield.XFieldElement {
+	var r xfield.XFieldElement
+	for i := range r.Coefficients {
+		r.Coefficients[i] = fieldct.Add(a.Coefficients[i], b.Coefficients[i])
+	}
+	return r
+}
+
+// Sub returns a - b.
+func Sub(a, b xfield.XFieldElement) xfield.XFieldElement {
+	var r xfield.XFieldElement
+	for i := range r.Coefficients {
+		r.Coefficients[i] = fieldct.Sub(a.Coefficients[i], b.Coefficients[i])
+	}
+	return r
+}
+
+// Neg returns -a.
+func Neg(a xfield.XFieldElement) xfield.XFieldElement {
+	return Sub(xfield.Zero, a)
+}
+
+// Mul returns a · b, using the reduction of xfield.XFieldElement.Mul with
+// constant-time additions.
+func Mul(a, b xfield.XFieldElement) xfield.XFieldElement {
+	c, bb, aa := a.Coefficients[0], a.Coefficients[1], a.Coefficients[2]
+	f, e, d := b.Coefficients[0], b.Coefficients[1], b.Coefficients[2]
+
+	ae := aa.Mul(e)
+	bd := bb.Mul(d)
+	ad := aa.Mul(d)
+	aeBd := fieldct.Add(ae, bd)
+
+	r0 := fieldct.Sub(c.Mul(f), aeBd)
+	r1 := fieldct.Add(fieldct.Sub(fieldct.Add(bb.Mul(f), c.Mul(e)), ad), aeBd)
+	r2 := fieldct.Add(fieldct.Add(fieldct.Add(aa.Mul(f), bb.Mul(e)), c.Mul(d)), ad)
+	return xfield.New([xfield.ExtensionDegree]field.Element{r0, r1, r2})
+}
+
+// MulConst returns a · s for a base field element s.
+func MulConst(a xfield.XFieldElement, s field.Element) xfield.XFieldElement {
+	var r xfield.XFieldElement
+	for i := range r.Coefficients {
+		r.Coefficients[i] = a.Coefficients[i].Mul(s)
+	}
+	return r
+}
+
+// Frobenius returns a^p.
+func Frobenius(a xfield.XFieldElement) xfield.XFieldElement {
+	return applyLinear(a, frobeniusX, frobeniusX2)
+}
+
+// applyLinear returns c₀ + c₁·imgX + c₂·imgX2 for a = c₀ + c₁x + c₂x².
+func applyLinear(a, imgX, imgX2 xfield.XFieldElement) xfield.XFieldElement {
+	r := Add(MulConst(imgX, a.Coefficients[1]), MulConst(imgX2, a.Coefficients[2]))
+	r.Coefficients[0] = fieldct.Add(r.Coefficients[0], a.Coefficients[0])
+	return r
+}
+
+// Inverse returns 1/a for nonzero a and Zero for zero, without distinguishing
+// the two cases. It uses the Itoh–Tsujii reduction to the base field:
+// a⁻¹ = φ(a)·φ²(a) · N(a)⁻¹ with N(a) = a·φ(a)·φ²(a) ∈ F_p, inverted with the
+// fixed addition chain of field/ct.
+func Inverse(a xfield.XFieldElement) xfield.XFieldElement {
+	conjugates := Mul(Frobenius(a), applyLinear(a, frobeniusSqX, frobeniusSqX2))
+	norm := Mul(a, conjugates).Coefficients[0]
+	return MulConst(conjugates, fieldct.Inverse(norm))
+}
+
+// Equal returns 1 if a == b and 0 otherwise.
+func Equal(a, b xfield.XFieldElement) int {
+	eq := 1
+	for i := range a.Coefficients {
+		eq &= fieldct.Equal(a.Coefficients[i], b.Coefficients[i])
+	}
+	return eq
+}
+
+// IsZero returns 1 if a is zero and 0 otherwise.
+func IsZero(a xfield.XFieldElement) int {
+	return Equal(a, xfield.Zero)
+}
+
+// Select returns a if cond == 1 and b if cond == 0. Only the lowest bit of cond is used.
+func Select(cond int, a, b xfield.XFieldElement) xfield.XFieldElement {
+	var r xfield.XFieldElement
+	for i := range r.Coefficients {
+		r.Coefficients[i] = fieldct.Select(cond, a.Coefficients[i], b.Coefficients[i])
+	}
+	return r
+}
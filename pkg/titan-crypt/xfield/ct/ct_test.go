@@ -0,0 +1,90 @@
+package ct
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/xfield"
+)
+
+func testElements() []xfield.XFieldElement {
+	rng := rand.New(rand.NewSource(544))
+	elems := []xfield.XFieldElement{
+		xfield.Zero, xfield.One, xfield.NewConst(field.Max),
+		xfield.New([xfield.ExtensionDegree]field.Element{field.Max, field.Max, field.Max}),
+		xfield.New([xfield.ExtensionDegree]field.Element{field.Zero, field.One, field.Zero}),
+	}
+	for i := 0; i < 30; i++ {
+		var coeffs [xfield.ExtensionDegree]field.Element
+		for j := range coeffs {
+			coeffs[j] = field.New(rng.Uint64())
+		}
+		elems = append(elems, xfield.New(coeffs))
+	}
+	return elems
+}
+
+func TestArithmeticMatchesXField(t *testing.T) {
+	elems := testElements()
+	for _, a := range elems {
+		for _, b := range elems {
+			if got := Add(a, b); !got.Equal(a.Add(b)) {
+				t.Fatalf("Add(%v, %v) = %v, want %v", a, b, got, a.Add(b))
+			}
+			if got := Sub(a, b); !got.Equal(a.Sub(b)) {
+				t.Fatalf("Sub(%v, %v) = %v, want %v", a, b, got, a.Sub(b))
+			}
+			if got := Mul(a, b); !got.Equal(a.Mul(b)) {
+				t.Fatalf("Mul(%v, %v) = %v, want %v", a, b, got, a.Mul(b))
+			}
+			if got, want := Equal(a, b), a.Equal(b); (got == 1) != want || (got != 0 && got != 1) {
+				t.Fatalf("Equal(%v, %v) = %d, want %v", a, b, got, want)
+			}
+			if !Select(1, a, b).Equal(a) || !Select(0, a, b).Equal(b) {
+				t.Fatalf("Select(·, %v, %v) picked the wrong operand", a, b)
+			}
+		}
+
+		if got := Neg(a); !got.Equal(a.Neg()) {
+			t.Fatalf("Neg(%v) = %v, want %v", a, got, a.Neg())
+		}
+		if got := Frobenius(a); !got.Equal(a.Frobenius()) {
+			t.Fatalf("Frobenius(%v) = %v, want %v", a, got, a.Frobenius())
+		}
+		if a.IsZero() {
+			if !Inverse(a).IsZero() || IsZero(a) != 1 {
+				t.Fatal("Inverse(0) should be 0 and IsZero(0) should be 1")
+			}
+			continue
+		}
+		if got := Inverse(a); !got.Equal(a.Inverse()) {
+			t.Fatalf("Inverse(%v) = %v, want %v", a, got, a.Inverse())
+		}
+		if IsZero(a) != 0 {
+			t.Fatalf("IsZero(%v) = 1", a)
+		}
+	}
+}
+
+func TestResultsAreCanonical(t *testing.T) {
+	elems := testElements()
+	for _, a := range elems {
+		for _, b := range elems {
+			for _, r := range []xfield.XFieldElement{Add(a, b), Sub(a, b), Mul(a, b), Neg(a), Inverse(a)} {
+				for _, c := range r.Coefficients {
+					if !field.IsCanonical(c.RawValue()) {
+						t.Fatalf("non-canonical raw value %d", c.RawValue())
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkInverse(b *testing.B) {
+	x := testElements()[10]
+	for i := 0; i < b.N; i++ {
+		Inverse(x)
+	}
+}
@@ -0,0 +1,153 @@
+package xfield
+
+import (
+	"encoding/gob"
+	"fmt"
+	"strings"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// ElementFromString parses an extension field element. Accepted forms are:
+//
+//	"c0, c1, c2"                   comma-separated coefficients of c₀ + c₁·x + c₂·x²
+//	"(c0, c1, c2)"                 the same, parenthesized, as written by MarshalText
+//	"c0_xfe"                       a lifted base field element, as printed by String
+//	"(c2·x² + c1·x + c0)"          the polynomial form printed by String
+//
+// Each coefficient is parsed with field.ElementFromString, so decimal and
+// 0x-prefixed hexadecimal values are accepted and values >= P are rejected.
+// Leading and trailing whitespace is ignored.
+// ElementFromString(x.String()) == x for every element x.
+func ElementFromString(s string) (XFieldElement, error) {
+	trimmed := strings.TrimSpace(s)
+
+	if constant, ok := strings.CutSuffix(trimmed, "_xfe"); ok {
+		c, err := field.ElementFromString(constant)
+		if err != nil {
+			return Zero, fmt.Errorf("invalid extension field element %q: %w", s, err)
+		}
+		return NewConst(c), nil
+	}
+
+	var parts []string
+	if strings.HasPrefix(trimmed, "(") && strings.HasSuffix(trimmed, ")") && strings.Contains(trimmed, " + ") {
+		terms := strings.Split(trimmed[1:len(trimmed)-1], " + ")
+		if len(terms) != ExtensionDegree {
+			return Zero, fmt.Errorf("invalid extension field element %q: expected %d terms, got %d", s, ExtensionDegree, len(terms))
+		}
+
+		c2, ok2 := strings.CutSuffix(terms[0], "·x²")
+		c1, ok1 := strings.CutSuffix(terms[1], "·x")
+		if !ok2 || !ok1 {
+			return Zero, fmt.Errorf("invalid extension field element %q: malformed polynomial terms", s)
+		}
+		parts = []string{terms[2], c1, c2}
+	} else {
+		if strings.HasPrefix(trimmed, "(") && strings.HasSuffix(trimmed, ")") {
+			trimmed = trimmed[1 : len(trimmed)-1]
+		}
+		parts = strings.Split(trimmed, ",")
+		if len(parts) != ExtensionDegree {
+			return Zero, fmt.Errorf("invalid extension field element %q: expected %d coefficients, got %d", s, ExtensionDegree, len(parts))
+		}
+	}
+
+	var coeffs [ExtensionDegree]field.Element
+	for i, part := range parts {
+		c, err := field.ElementFromString(part)
+		if err != nil {
+			return Zero, fmt.Errorf("invalid coefficient %d of extension field element %q: %w", i, s, err)
+		}
+		coeffs[i] = c
+	}
+
+	return New(coeffs), nil
+}
+
+// Parse is ElementFromString, accepting every form listed there, for use with
+// config files, command-line flags and golden test vectors.
+func Parse(s string) (XFieldElement, error) {
+	return ElementFromString(s)
+}
+
+// MarshalText implements encoding.TextMarshaler. It writes the stable form
+// "(c0, c1, c2)" with canonical decimal coefficients, which unlike String does
+// not depend on whether x lies in the base field.
+func (x XFieldElement) MarshalText() ([]byte, error) {
+	c := x.Coefficients
+	return fmt.Appendf(nil, "(%d, %d, %d)", c[0].Value(), c[1].Value(), c[2].Value()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the forms of Parse.
+// On error x is left unchanged.
+func (x *XFieldElement) UnmarshalText(text []byte) error {
+	parsed, err := ElementFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*x = parsed
+	return nil
+}
+
+func init() {
+	// Lets XFieldElements travel in interface-typed gob values
+	gob.Register(XFieldElement{})
+}
+
+// ByteLen is the length of the canonical byte encoding of an XFieldElement.
+const ByteLen = ExtensionDegree * 8
+
+// Bytes returns the canonical 24-byte encoding of x: the coefficients c₀, c₁, c₂
+// of c₀ + c₁·x + c₂·x² in that order, each as the 8-byte little-endian canonical
+// value of field.Element.Bytes. This is the BFieldCodec sequence [c₀, c₁, c₂]
+// of twenty-first's XFieldElement (see bfieldcodec.EncodeXFieldElement) written
+// out as u64 words, so encodings agree across implementations.
+func (x XFieldElement) Bytes() [ByteLen]byte {
+	var data [ByteLen]byte
+	for i, c := range x.Coefficients {
+		b := c.Bytes()
+		copy(data[i*8:], b[:])
+	}
+	return data
+}
+
+// SetBytes sets x to the element encoded by data in the form produced by Bytes.
+// Returns an error, leaving x unchanged, if len(data) != ByteLen or if a
+// coefficient is not canonical (>= P).
+func (x *XFieldElement) SetBytes(data []byte) error {
+	if len(data) != ByteLen {
+		return fmt.Errorf("invalid data length: expected %d bytes, got %d", ByteLen, len(data))
+	}
+
+	var coeffs [ExtensionDegree]field.Element
+	for i := range coeffs {
+		if err := coeffs[i].SetBytes(data[i*8 : (i+1)*8]); err != nil {
+			return fmt.Errorf("invalid coefficient %d: %w", i, err)
+		}
+	}
+	x.Coefficients = coeffs
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using the canonical form of Bytes.
+func (x XFieldElement) MarshalBinary() ([]byte, error) {
+	data := x.Bytes()
+	return data[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It is SetBytes, so
+// non-canonical coefficients are rejected.
+func (x *XFieldElement) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data)
+}
+
+// GobEncode implements gob.GobEncoder with the 24-byte form of MarshalBinary.
+func (x XFieldElement) GobEncode() ([]byte, error) {
+	return x.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (x *XFieldElement) GobDecode(data []byte) error {
+	return x.UnmarshalBinary(data)
+}
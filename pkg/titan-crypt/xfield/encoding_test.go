@@ -0,0 +1,192 @@
+package xfield
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestElementFromString(t *testing.T) {
+	tests := []struct {
+		input string
+		want  XFieldElement
+	}{
+		{"1,2,3", New([3]field.Element{field.New(1), field.New(2), field.New(3)})},
+		{" 1 , 2 , 3 ", New([3]field.Element{field.New(1), field.New(2), field.New(3)})},
+		{"0x10,0,0xff", New([3]field.Element{field.New(16), field.Zero, field.New(255)})},
+		{"0,0,0", Zero},
+		{"42_xfe", NewU64(42)},
+		{"(3·x² + 2·x + 1)", New([3]field.Element{field.New(1), field.New(2), field.New(3)})},
+		{"(1, 2, 3)", New([3]field.Element{field.New(1), field.New(2), field.New(3)})},
+		{" (0x10,0,0xff) ", New([3]field.Element{field.New(16), field.Zero, field.New(255)})},
+	}
+
+	for _, tt := range tests {
+		got, err := ElementFromString(tt.input)
+		if err != nil {
+			t.Errorf("ElementFromString(%q) failed: %v", tt.input, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ElementFromString(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestElementFromStringErrors(t *testing.T) {
+	inputs := []string{
+		"",
+		"1,2",
+		"1,2,3,4",
+		"1,,3",
+		"1,-2,3",
+		"1,2,18446744069414584321",
+		"a,b,c",
+		"_xfe",
+		"-1_xfe",
+		"(3·x² + 2·x)",
+		"(3·x + 2·x + 1)",
+		"(3·x² + 2·x + 1",
+		"(1, 2)",
+		"((1, 2, 3))",
+	}
+
+	for _, input := range inputs {
+		if got, err := ElementFromString(input); err == nil {
+			t.Errorf("ElementFromString(%q) = %v, expected error", input, got)
+		}
+	}
+}
+
+func TestElementFromStringRoundTrip(t *testing.T) {
+	elements := []XFieldElement{
+		Zero,
+		One,
+		NewU64(field.P - 1),
+		New([3]field.Element{field.New(1), field.New(2), field.New(3)}),
+		New([3]field.Element{field.Zero, field.One, field.Zero}),
+		New([3]field.Element{field.Max, field.Max, field.Max}),
+	}
+
+	for _, x := range elements {
+		parsed, err := ElementFromString(x.String())
+		if err != nil {
+			t.Fatalf("ElementFromString(%q) failed: %v", x.String(), err)
+		}
+		if !parsed.Equal(x) {
+			t.Errorf("round trip of %v gave %v", x, parsed)
+		}
+	}
+}
+
+func TestXFieldElementText(t *testing.T) {
+	x := New([3]field.Element{field.New(1), field.Max, field.Zero})
+	text, err := x.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText failed: %v", err)
+	}
+	if want := "(1, 18446744069414584320, 0)"; string(text) != want {
+		t.Errorf("MarshalText = %q, want %q", text, want)
+	}
+
+	// Base field elements keep the tuple form, unlike String
+	if text, _ := NewU64(5).MarshalText(); string(text) != "(5, 0, 0)" {
+		t.Errorf("MarshalText(5) = %q", text)
+	}
+
+	for _, y := range []XFieldElement{Zero, One, x, NewU64(5)} {
+		text, _ := y.MarshalText()
+		var parsed XFieldElement
+		if err := parsed.UnmarshalText(text); err != nil || !parsed.Equal(y) {
+			t.Errorf("text round trip of %v = %v, %v", y, parsed, err)
+		}
+		if p, err := Parse(string(text)); err != nil || !p.Equal(y) {
+			t.Errorf("Parse(%q) = %v, %v", text, p, err)
+		}
+	}
+
+	parsed := One
+	if err := parsed.UnmarshalText([]byte("(1, 2)")); err == nil || !parsed.Equal(One) {
+		t.Error("failed UnmarshalText should return an error and leave the receiver unchanged")
+	}
+}
+
+func TestXFieldElementBytes(t *testing.T) {
+	x := New([ExtensionDegree]field.Element{field.New(1), field.New(0x0102030405060708), field.Max})
+	want := [ByteLen]byte{
+		1, 0, 0, 0, 0, 0, 0, 0,
+		8, 7, 6, 5, 4, 3, 2, 1,
+		0, 0, 0, 0, 0xFF, 0xFF, 0xFF, 0xFF,
+	}
+	if got := x.Bytes(); got != want {
+		t.Errorf("Bytes() = %x, want %x", got, want)
+	}
+
+	var y XFieldElement
+	if err := y.SetBytes(want[:]); err != nil || !y.Equal(x) {
+		t.Errorf("SetBytes round trip = %v, %v; want %v", y, err, x)
+	}
+	if data, _ := x.MarshalBinary(); string(data) != string(want[:]) {
+		t.Error("MarshalBinary differs from Bytes")
+	}
+}
+
+func TestXFieldElementGob(t *testing.T) {
+	in := []XFieldElement{Zero, One, New([ExtensionDegree]field.Element{field.Max, field.New(2), field.New(1 << 40)})}
+
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(in); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	var iface any = in[2]
+	if err := enc.Encode(&iface); err != nil {
+		t.Fatalf("Encode of interface value failed: %v", err)
+	}
+
+	dec := gob.NewDecoder(&buf)
+	var out []XFieldElement
+	if err := dec.Decode(&out); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if len(out) != len(in) {
+		t.Fatalf("decoded %d elements, want %d", len(out), len(in))
+	}
+	for i := range in {
+		if !out[i].Equal(in[i]) {
+			t.Errorf("element %d = %v, want %v", i, out[i], in[i])
+		}
+	}
+	var outIface any
+	if err := dec.Decode(&outIface); err != nil {
+		t.Fatalf("Decode of interface value failed: %v", err)
+	}
+	if got, ok := outIface.(XFieldElement); !ok || !got.Equal(in[2]) {
+		t.Errorf("interface value = %v, want %v", outIface, in[2])
+	}
+}
+
+func TestXFieldElementUnmarshalBinaryRejects(t *testing.T) {
+	x := One
+	if err := x.UnmarshalBinary(make([]byte, ByteLen-1)); err == nil {
+		t.Error("UnmarshalBinary: expected error for short data")
+	}
+	if err := x.SetBytes(make([]byte, ByteLen-1)); err == nil {
+		t.Error("SetBytes: expected error for short data")
+	}
+	if err := x.SetBytes(make([]byte, ByteLen+1)); err == nil {
+		t.Error("SetBytes: expected error for long data")
+	}
+	data, _ := One.MarshalBinary()
+	for i := 8; i < 16; i++ {
+		data[i] = 0xFF
+	}
+	if err := x.UnmarshalBinary(data); err == nil {
+		t.Error("expected error for non-canonical coefficient")
+	}
+	if !x.Equal(One) {
+		t.Error("failed UnmarshalBinary modified the receiver")
+	}
+}
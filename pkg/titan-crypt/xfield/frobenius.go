@@ -0,0 +1,119 @@
+package xfield
+
+import (
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/internal/parallel"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/polynomial"
+)
+
+// frobeniusX and frobeniusX2 are the images x^p and x^(2p) of the basis
+// elements x and x² under the Frobenius automorphism; frobeniusSqX and
+// frobeniusSqX2 are their images x^(p²) and x^(2p²) under φ².
+var (
+	frobeniusX    = New([ExtensionDegree]field.Element{field.Zero, field.One, field.Zero}).Pow(field.P)
+	frobeniusX2   = frobeniusX.Mul(frobeniusX)
+	frobeniusSqX  = frobeniusX.Frobenius()
+	frobeniusSqX2 = frobeniusSqX.Mul(frobeniusSqX)
+)
+
+// Frobenius applies the Frobenius automorphism φ(y) = y^p.
+// Because φ fixes F_p and is additive, φ(c₀ + c₁x + c₂x²) = c₀ + c₁·x^p + c₂·x^(2p),
+// which costs two scalar multiplications by precomputed constants instead of a full Pow.
+func (x XFieldElement) Frobenius() XFieldElement {
+	return x.applyLinear(frobeniusX, frobeniusX2)
+}
+
+// FrobeniusPow applies φ^k, i.e. returns x^(p^k), using precomputed images of
+// the basis. Since φ³ is the identity, only k mod 3 matters.
+func (x XFieldElement) FrobeniusPow(k uint) XFieldElement {
+	switch k % ExtensionDegree {
+	case 1:
+		return x.applyLinear(frobeniusX, frobeniusX2)
+	case 2:
+		return x.applyLinear(frobeniusSqX, frobeniusSqX2)
+	default:
+		return x
+	}
+}
+
+// Conjugates returns the Galois conjugates {x, x^p, x^(p²)} of x, in that
+// order, from the precomputed images of the basis. Their product is Norm(x)
+// and their sum is Trace(x), and any symmetric function of them lies in F_p.
+func (x XFieldElement) Conjugates() [ExtensionDegree]XFieldElement {
+	return [ExtensionDegree]XFieldElement{
+		x,
+		x.applyLinear(frobeniusX, frobeniusX2),
+		x.applyLinear(frobeniusSqX, frobeniusSqX2),
+	}
+}
+
+// applyLinear returns c₀ + c₁·imgX + c₂·imgX2, the image of x under the F_p-linear
+// map sending the basis elements x and x² to imgX and imgX2.
+func (x XFieldElement) applyLinear(imgX, imgX2 XFieldElement) XFieldElement {
+	c0, c1, c2 := x.Coefficients[0], x.Coefficients[1], x.Coefficients[2]
+	return imgX.MulConst(c1).Add(imgX2.MulConst(c2)).AddConst(c0)
+}
+
+// FrobeniusFixed reports whether x is a fixed point of the Frobenius automorphism,
+// i.e. x^p = x. The fixed field of φ is F_p, so this agrees with x.IsInBaseField().
+func FrobeniusFixed(x XFieldElement) bool {
+	return x.Frobenius().Equal(x)
+}
+
+// FrobeniusVec returns the Frobenius image of every element. In the power basis
+// φ is an F_p-linear map rather than a coordinate permutation, so each element
+// costs two scalar multiplications. Large inputs are split across goroutines.
+func FrobeniusVec(xs []XFieldElement) []XFieldElement {
+	return FrobeniusPowVec(xs, 1)
+}
+
+// FrobeniusPowVec returns φ^k of every element, i.e. xs[i]^(p^k). Since φ³ is
+// the identity, FrobeniusPowVec(xs, 3) is a copy of xs.
+func FrobeniusPowVec(xs []XFieldElement, k uint) []XFieldElement {
+	result := make([]XFieldElement, len(xs))
+	parallel.Chunks(len(xs), func(start, end int) {
+		for i := start; i < end; i++ {
+			result[i] = xs[i].FrobeniusPow(k)
+		}
+	})
+	return result
+}
+
+// Norm returns the field norm N(x) = x · φ(x) · φ²(x), the product of the
+// conjugates of x, which lies in F_p. It is multiplicative and zero only for
+// zero, and x⁻¹ = φ(x)·φ²(x) / N(x) gives an inversion that needs a single
+// base field inverse.
+func (x XFieldElement) Norm() field.Element {
+	c := x.Conjugates()
+	return c[0].Mul(c[1]).Mul(c[2]).Coefficients[0]
+}
+
+// Trace returns the field trace Tr(x) = x + φ(x) + φ²(x), which lies in F_p.
+// The trace is F_p-linear, and for the modulus x³ - x + 1 the basis traces are
+// Tr(1) = 3, Tr(x) = 0 (the sum of the roots) and Tr(x²) = 2, so
+// Tr(c₀ + c₁x + c₂x²) = 3c₀ + 2c₂ without computing any conjugate.
+func (x XFieldElement) Trace() field.Element {
+	c0, c2 := x.Coefficients[0], x.Coefficients[2]
+	return c0.Add(c0).Add(c0).Add(c2).Add(c2)
+}
+
+// MinimalPolynomial returns the monic polynomial over F_p of least degree with
+// x as a root. For x in F_p it is X - x. Otherwise x generates the whole
+// extension, since 3 is prime and there is no intermediate field, and it is
+// the product of X - c over the conjugates c of x:
+// X³ - Tr(x)·X² + e₂·X - N(x), where e₂ is the sum of pairwise products of
+// the conjugates.
+func (x XFieldElement) MinimalPolynomial() *polynomial.Polynomial {
+	if x.IsInBaseField() {
+		return polynomial.New([]field.Element{x.Coefficients[0].Neg(), field.One})
+	}
+
+	c := x.Conjugates()
+	e2 := c[0].Mul(c[1]).Add(c[0].Mul(c[2])).Add(c[1].Mul(c[2]))
+	return polynomial.New([]field.Element{
+		x.Norm().Neg(),
+		e2.Coefficients[0],
+		x.Trace().Neg(),
+		field.One,
+	})
+}
@@ -0,0 +1,206 @@
+package xfield
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestFrobeniusMatchesPow(t *testing.T) {
+	rng := rand.New(rand.NewSource(416))
+	for i := 0; i < 100; i++ {
+		x := randomXFieldElement(rng)
+		if !x.Frobenius().Equal(x.Pow(field.P)) {
+			t.Fatalf("Frobenius(%v) != %v^p", x, x)
+		}
+		if !x.Frobenius().Frobenius().Frobenius().Equal(x) {
+			t.Fatalf("φ³(%v) != %v", x, x)
+		}
+	}
+}
+
+func TestFrobeniusPow(t *testing.T) {
+	rng := rand.New(rand.NewSource(536))
+	for i := 0; i < 100; i++ {
+		x := randomXFieldElement(rng)
+		want := x
+		for k := uint(0); k < 7; k++ {
+			if got := x.FrobeniusPow(k); !got.Equal(want) {
+				t.Fatalf("FrobeniusPow(%d) of %v = %v, want %v", k, x, got, want)
+			}
+			want = want.Pow(field.P)
+		}
+	}
+}
+
+func TestConjugates(t *testing.T) {
+	rng := rand.New(rand.NewSource(548))
+	for i := 0; i < 100; i++ {
+		x := randomXFieldElement(rng)
+		c := x.Conjugates()
+		for k := range c {
+			if !c[k].Equal(x.FrobeniusPow(uint(k))) {
+				t.Fatalf("conjugate %d of %v = %v, want %v", k, x, c[k], x.FrobeniusPow(uint(k)))
+			}
+		}
+		if !c[0].Mul(c[1]).Mul(c[2]).Equal(NewConst(x.Norm())) ||
+			!c[0].Add(c[1]).Add(c[2]).Equal(NewConst(x.Trace())) {
+			t.Fatalf("conjugates of %v do not multiply to the norm and add to the trace", x)
+		}
+
+		// Elementary symmetric function e₂ lies in the base field
+		if e2 := c[0].Mul(c[1]).Add(c[0].Mul(c[2])).Add(c[1].Mul(c[2])); !e2.IsInBaseField() {
+			t.Fatalf("e₂ of the conjugates of %v = %v, not in the base field", x, e2)
+		}
+	}
+
+	b := NewU64(7)
+	if c := b.Conjugates(); !c[1].Equal(b) || !c[2].Equal(b) {
+		t.Errorf("base field element has conjugates %v", c)
+	}
+}
+
+func TestNormAndTrace(t *testing.T) {
+	rng := rand.New(rand.NewSource(537))
+	for i := 0; i < 100; i++ {
+		x, y := randomXFieldElement(rng), randomXFieldElement(rng)
+		conj1, conj2 := x.Frobenius(), x.FrobeniusPow(2)
+
+		sum := x.Add(conj1).Add(conj2)
+		if !sum.IsInBaseField() || !x.Trace().Equal(sum.Coefficients[0]) {
+			t.Fatalf("Trace(%v) = %v, want %v", x, x.Trace(), sum)
+		}
+		product := x.Mul(conj1).Mul(conj2)
+		if !product.IsInBaseField() || !x.Norm().Equal(product.Coefficients[0]) {
+			t.Fatalf("Norm(%v) = %v, want %v", x, x.Norm(), product)
+		}
+
+		if !x.Mul(y).Norm().Equal(x.Norm().Mul(y.Norm())) {
+			t.Fatal("Norm is not multiplicative")
+		}
+		if !x.Add(y).Trace().Equal(x.Trace().Add(y.Trace())) {
+			t.Fatal("Trace is not additive")
+		}
+
+		// Inversion through the norm agrees with Inverse
+		if !x.IsZero() {
+			viaNorm := conj1.Mul(conj2).MulConst(x.Norm().Inverse())
+			if !viaNorm.Equal(x.Inverse()) {
+				t.Fatalf("norm-based inverse of %v = %v, want %v", x, viaNorm, x.Inverse())
+			}
+		}
+	}
+
+	// On F_p the norm is c³ and the trace 3c
+	c := field.New(12345)
+	if !NewConst(c).Norm().Equal(c.Mul(c).Mul(c)) || !NewConst(c).Trace().Equal(field.New(3*12345)) {
+		t.Error("Norm or Trace wrong on the base field")
+	}
+	if !Zero.Norm().IsZero() {
+		t.Error("Norm(0) should be 0")
+	}
+}
+
+func TestFrobeniusFixedAgreesWithIsInBaseField(t *testing.T) {
+	rng := rand.New(rand.NewSource(416))
+	for i := 0; i < 100; i++ {
+		base := NewConst(field.New(rng.Uint64()))
+		if !FrobeniusFixed(base) || !base.IsInBaseField() {
+			t.Fatalf("base field element %v not fixed by Frobenius", base)
+		}
+
+		x := randomXFieldElement(rng)
+		if FrobeniusFixed(x) != x.IsInBaseField() {
+			t.Fatalf("FrobeniusFixed(%v) = %v, IsInBaseField = %v", x, FrobeniusFixed(x), x.IsInBaseField())
+		}
+	}
+
+	if !FrobeniusFixed(Zero) || !FrobeniusFixed(One) {
+		t.Fatal("Zero and One must be fixed by Frobenius")
+	}
+	if FrobeniusFixed(New([ExtensionDegree]field.Element{field.Zero, field.One, field.Zero})) {
+		t.Fatal("x must not be fixed by Frobenius")
+	}
+}
+
+func TestFrobeniusVec(t *testing.T) {
+	rng := rand.New(rand.NewSource(422))
+	xs := make([]XFieldElement, 5000)
+	for i := range xs {
+		xs[i] = randomXFieldElement(rng)
+	}
+
+	phi := FrobeniusVec(xs)
+	for k := uint(0); k <= 4; k++ {
+		got := FrobeniusPowVec(xs, k)
+		for i, x := range xs {
+			want := x
+			for j := uint(0); j < k; j++ {
+				want = want.Frobenius()
+			}
+			if !got[i].Equal(want) {
+				t.Fatalf("FrobeniusPowVec(k=%d)[%d] = %v, want %v", k, i, got[i], want)
+			}
+			if k == 1 && !phi[i].Equal(want) {
+				t.Fatalf("FrobeniusVec[%d] = %v, want %v", i, phi[i], want)
+			}
+			if k == 3 && !got[i].Equal(x) {
+				t.Fatalf("FrobeniusPowVec(x, 3)[%d] != x", i)
+			}
+		}
+	}
+
+	if got := FrobeniusVec(nil); len(got) != 0 {
+		t.Errorf("FrobeniusVec(nil) has length %d", len(got))
+	}
+}
+
+func BenchmarkFrobeniusVec(b *testing.B) {
+	rng := rand.New(rand.NewSource(422))
+	xs := make([]XFieldElement, 1<<18)
+	for i := range xs {
+		xs[i] = randomXFieldElement(rng)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FrobeniusVec(xs)
+	}
+}
+
+func TestMinimalPolynomial(t *testing.T) {
+	rng := rand.New(rand.NewSource(554))
+	evaluate := func(coeffs []field.Element, x XFieldElement) XFieldElement {
+		result := Zero
+		for i := len(coeffs) - 1; i >= 0; i-- {
+			result = result.Mul(x).AddConst(coeffs[i])
+		}
+		return result
+	}
+
+	for i := 0; i < 100; i++ {
+		x := randomXFieldElement(rng)
+		m := x.MinimalPolynomial()
+		if m.Degree() != 3 || !m.LeadingCoefficient().IsOne() {
+			t.Fatalf("minimal polynomial of %v is %v", x, m)
+		}
+		for _, c := range x.Conjugates() {
+			if !evaluate(m.Coefficients(), c).IsZero() {
+				t.Fatalf("conjugate %v is not a root of %v", c, m)
+			}
+		}
+	}
+
+	// x is a root of the defining polynomial
+	indeterminate := New([3]field.Element{field.Zero, field.One, field.Zero})
+	if m := indeterminate.MinimalPolynomial(); !m.Equal(ShahPolynomial()) {
+		t.Errorf("minimal polynomial of x = %v, want %v", m, ShahPolynomial())
+	}
+
+	for _, b := range []XFieldElement{Zero, One, NewU64(42)} {
+		m := b.MinimalPolynomial()
+		if m.Degree() != 1 || !evaluate(m.Coefficients(), b).IsZero() {
+			t.Errorf("minimal polynomial of %v = %v", b, m)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package xfield
+
+import (
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// ProjectCoordinate returns coefficient i of the element, for i in [0, ExtensionDegree).
+// It is the F_p-linear projection onto the i-th basis vector 1, x or x².
+// Panics if i is out of range.
+func (x XFieldElement) ProjectCoordinate(i int) field.Element {
+	return x.Coefficients[i]
+}
+
+// RandomLinearMapToBase returns the F_p-linear functional e ↦ α₀·e₀ + α₁·e₁ + α₂·e₂.
+// With verifier-chosen alphas this batches extension field constraints into a
+// single base field constraint.
+func RandomLinearMapToBase(alphas [ExtensionDegree]field.Element) func(XFieldElement) field.Element {
+	return func(e XFieldElement) field.Element {
+		return alphas[0].Mul(e.Coefficients[0]).
+			Add(alphas[1].Mul(e.Coefficients[1])).
+			Add(alphas[2].Mul(e.Coefficients[2]))
+	}
+}
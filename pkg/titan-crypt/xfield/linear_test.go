@@ -0,0 +1,59 @@
+package xfield
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestProjectCoordinate(t *testing.T) {
+	x := New([3]field.Element{field.New(1), field.New(2), field.New(3)})
+	for i := 0; i < ExtensionDegree; i++ {
+		if got := x.ProjectCoordinate(i); !got.Equal(field.New(uint64(i + 1))) {
+			t.Errorf("ProjectCoordinate(%d) = %v, want %d", i, got, i+1)
+		}
+	}
+}
+
+func TestRandomLinearMapToBaseIsLinear(t *testing.T) {
+	rng := rand.New(rand.NewSource(394))
+
+	for trial := 0; trial < 100; trial++ {
+		alphas := [3]field.Element{field.New(rng.Uint64()), field.New(rng.Uint64()), field.New(rng.Uint64())}
+		f := RandomLinearMapToBase(alphas)
+
+		a, b := randomXFieldElement(rng), randomXFieldElement(rng)
+		s := field.New(rng.Uint64())
+
+		// f(a + b) = f(a) + f(b)
+		if got, want := f(a.Add(b)), f(a).Add(f(b)); !got.Equal(want) {
+			t.Fatalf("f(a+b) = %v, want f(a)+f(b) = %v", got, want)
+		}
+
+		// f(s·a) = s·f(a) for scalars s in the base field
+		if got, want := f(a.MulConst(s)), s.Mul(f(a)); !got.Equal(want) {
+			t.Fatalf("f(s·a) = %v, want s·f(a) = %v", got, want)
+		}
+
+		if !f(Zero).IsZero() {
+			t.Fatal("f(0) != 0")
+		}
+	}
+}
+
+func TestRandomLinearMapToBaseBasis(t *testing.T) {
+	alphas := [3]field.Element{field.New(5), field.New(7), field.New(11)}
+	f := RandomLinearMapToBase(alphas)
+
+	basis := []XFieldElement{
+		New([3]field.Element{field.One, field.Zero, field.Zero}),
+		New([3]field.Element{field.Zero, field.One, field.Zero}),
+		New([3]field.Element{field.Zero, field.Zero, field.One}),
+	}
+	for i, e := range basis {
+		if got := f(e); !got.Equal(alphas[i]) {
+			t.Errorf("f(basis[%d]) = %v, want %v", i, got, alphas[i])
+		}
+	}
+}
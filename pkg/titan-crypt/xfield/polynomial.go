@@ -0,0 +1,113 @@
+package xfield
+
+import (
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/polynomial"
+)
+
+// Polynomial represents a univariate polynomial with coefficients in F_p^3.
+// Coefficients are stored in order of increasing degree (coefficients[0] is the constant term).
+// This is equivalent to twenty-first's Polynomial<XFieldElement>.
+type Polynomial struct {
+	// coefficients in order of increasing degree
+	coefficients []XFieldElement
+}
+
+// NewPolynomial creates a new extension field polynomial from coefficients.
+// Coefficients are in order of increasing degree: [c0, c1, c2, ...] represents c0 + c1*x + c2*x^2 + ...
+func NewPolynomial(coefficients []XFieldElement) *Polynomial {
+	p := &Polynomial{
+		coefficients: make([]XFieldElement, len(coefficients)),
+	}
+	copy(p.coefficients, coefficients)
+	p.normalize()
+	return p
+}
+
+// LiftPolynomial views a base field polynomial as a polynomial over the extension field.
+// Every coefficient is lifted via NewConst, so for any base field point z:
+//
+//	LiftPolynomial(p).Evaluate(NewConst(z)) == NewConst(p.Evaluate(z))
+//
+// This is equivalent to twenty-first's Polynomial::<BFieldElement>::lift()
+func LiftPolynomial(p *polynomial.Polynomial) *Polynomial {
+	baseCoeffs := p.Coefficients()
+	coeffs := make([]XFieldElement, len(baseCoeffs))
+	for i, c := range baseCoeffs {
+		coeffs[i] = NewConst(c)
+	}
+	return &Polynomial{coefficients: coeffs}
+}
+
+// Degree returns the degree of the polynomial.
+// Returns -1 for the zero polynomial.
+func (p *Polynomial) Degree() int {
+	deg := len(p.coefficients) - 1
+	for deg >= 0 && p.coefficients[deg].IsZero() {
+		deg--
+	}
+	return deg
+}
+
+// Coefficients returns the polynomial's coefficients in order of increasing degree.
+// The leading coefficient is guaranteed to be non-zero (except for the zero polynomial).
+func (p *Polynomial) Coefficients() []XFieldElement {
+	deg := p.Degree()
+	if deg < 0 {
+		return []XFieldElement{}
+	}
+	return p.coefficients[:deg+1]
+}
+
+// IsZero returns true if this is the zero polynomial.
+func (p *Polynomial) IsZero() bool {
+	return p.Degree() < 0
+}
+
+// Equal returns true if two polynomials are equal.
+func (p *Polynomial) Equal(other *Polynomial) bool {
+	if p.Degree() != other.Degree() {
+		return false
+	}
+
+	for i := 0; i <= p.Degree(); i++ {
+		if !p.coefficients[i].Equal(other.coefficients[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate evaluates the polynomial at an extension field point using Horner's method.
+func (p *Polynomial) Evaluate(x XFieldElement) XFieldElement {
+	if p.IsZero() {
+		return Zero
+	}
+
+	result := p.coefficients[len(p.coefficients)-1]
+	for i := len(p.coefficients) - 2; i >= 0; i-- {
+		result = result.Mul(x).Add(p.coefficients[i])
+	}
+	return result
+}
+
+// EvaluateBase evaluates the polynomial at a base field point.
+// Each Horner step scales by the base field element instead of lifting it first.
+func (p *Polynomial) EvaluateBase(x field.Element) XFieldElement {
+	if p.IsZero() {
+		return Zero
+	}
+
+	result := p.coefficients[len(p.coefficients)-1]
+	for i := len(p.coefficients) - 2; i >= 0; i-- {
+		result = result.MulConst(x).Add(p.coefficients[i])
+	}
+	return result
+}
+
+// normalize removes leading zero coefficients.
+func (p *Polynomial) normalize() {
+	for len(p.coefficients) > 0 && p.coefficients[len(p.coefficients)-1].IsZero() {
+		p.coefficients = p.coefficients[:len(p.coefficients)-1]
+	}
+}
@@ -0,0 +1,78 @@
+package xfield
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/polynomial"
+)
+
+func randomBasePolynomial(rng *rand.Rand, degree int) *polynomial.Polynomial {
+	coeffs := make([]field.Element, degree+1)
+	for i := range coeffs {
+		coeffs[i] = field.New(rng.Uint64())
+	}
+	return polynomial.New(coeffs)
+}
+
+func TestNewPolynomialNormalizes(t *testing.T) {
+	p := NewPolynomial([]XFieldElement{NewU64(1), NewU64(2), Zero, Zero})
+	if p.Degree() != 1 {
+		t.Errorf("Degree() = %d, want 1", p.Degree())
+	}
+	if len(p.Coefficients()) != 2 {
+		t.Errorf("len(Coefficients()) = %d, want 2", len(p.Coefficients()))
+	}
+
+	zero := NewPolynomial(nil)
+	if !zero.IsZero() || zero.Degree() != -1 {
+		t.Errorf("empty polynomial should be zero with degree -1, got degree %d", zero.Degree())
+	}
+	if !zero.Evaluate(NewU64(5)).IsZero() {
+		t.Error("zero polynomial should evaluate to zero")
+	}
+}
+
+func TestLiftPolynomialEvaluationConsistency(t *testing.T) {
+	rng := rand.New(rand.NewSource(380))
+
+	for _, degree := range []int{0, 1, 2, 7, 31, 64} {
+		p := randomBasePolynomial(rng, degree)
+		lifted := LiftPolynomial(p)
+
+		if lifted.Degree() != p.Degree() {
+			t.Fatalf("lifted degree = %d, want %d", lifted.Degree(), p.Degree())
+		}
+
+		for i := 0; i < 10; i++ {
+			z := field.New(rng.Uint64())
+			want := NewConst(p.Evaluate(z))
+
+			if got := lifted.Evaluate(NewConst(z)); !got.Equal(want) {
+				t.Errorf("degree %d: Evaluate(lift(z)) = %v, want %v", degree, got, want)
+			}
+			if got := lifted.EvaluateBase(z); !got.Equal(want) {
+				t.Errorf("degree %d: EvaluateBase(z) = %v, want %v", degree, got, want)
+			}
+		}
+	}
+}
+
+func TestLiftPolynomialZero(t *testing.T) {
+	lifted := LiftPolynomial(polynomial.Zero())
+	if !lifted.IsZero() {
+		t.Errorf("lift of zero polynomial should be zero, got degree %d", lifted.Degree())
+	}
+}
+
+func TestPolynomialEvaluateExtensionPoint(t *testing.T) {
+	// p(x) = 1 + 2x + 3x^2 evaluated at an extension element, computed by hand.
+	p := NewPolynomial([]XFieldElement{NewU64(1), NewU64(2), NewU64(3)})
+	z := New([3]field.Element{field.New(4), field.New(5), field.New(6)})
+
+	want := NewU64(1).Add(NewU64(2).Mul(z)).Add(NewU64(3).Mul(z).Mul(z))
+	if got := p.Evaluate(z); !got.Equal(want) {
+		t.Errorf("p(z) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,54 @@
+package xfield
+
+import (
+	"math/big"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+var (
+	bigP = new(big.Int).SetUint64(field.P)
+
+	// bigGroupOrder is p³ - 1, the order of the multiplicative group of F_p³.
+	bigGroupOrder = new(big.Int).Sub(new(big.Int).Exp(bigP, big.NewInt(ExtensionDegree), nil), big.NewInt(1))
+)
+
+// PowSigned returns x^exp for a signed exponent: Pow for exp >= 0 and
+// (1/x)^|exp| for exp < 0, as field.Element.PowSigned does.
+//
+// Panics if x is zero and exp is negative.
+func (x XFieldElement) PowSigned(exp int64) XFieldElement {
+	if exp >= 0 {
+		return x.Pow(uint64(exp))
+	}
+	// -exp overflows for math.MinInt64, but its uint64 conversion is still 2^63
+	return x.Inverse().Pow(uint64(-exp))
+}
+
+// PowBig returns x^exp for an exponent of any size and sign. For nonzero x the
+// exponent is reduced modulo p³ - 1 and written in base p as e₀ + e₁·p + e₂·p²,
+// so x^exp = x^e₀ · φ(x^e₁) · φ²(x^e₂): three 64-bit exponentiations and two
+// Frobenius maps regardless of the size of exp. Negative exponents invert x;
+// 0^0 is One.
+//
+// Panics if x is zero and exp is negative.
+func (x XFieldElement) PowBig(exp *big.Int) XFieldElement {
+	if exp.Sign() < 0 {
+		return x.Inverse().PowBig(new(big.Int).Neg(exp))
+	}
+	if x.IsZero() {
+		if exp.Sign() == 0 {
+			return One
+		}
+		return Zero
+	}
+
+	rest := new(big.Int).Mod(exp, bigGroupOrder)
+	digit := new(big.Int)
+	result := One
+	for k := uint(0); k < ExtensionDegree; k++ {
+		rest.DivMod(rest, bigP, digit)
+		result = result.Mul(x.Pow(digit.Uint64()).FrobeniusPow(k))
+	}
+	return result
+}
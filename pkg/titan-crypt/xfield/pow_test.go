@@ -0,0 +1,64 @@
+package xfield
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// powBigNaive is square-and-multiply over the bits of exp.
+func powBigNaive(x XFieldElement, exp *big.Int) XFieldElement {
+	result := One
+	for i := exp.BitLen() - 1; i >= 0; i-- {
+		result = result.Mul(result)
+		if exp.Bit(i) == 1 {
+			result = result.Mul(x)
+		}
+	}
+	return result
+}
+
+func TestPowBig(t *testing.T) {
+	rng := rand.New(rand.NewSource(543))
+	exps := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(12345), new(big.Int).Set(bigGroupOrder)}
+	for i := 0; i < 10; i++ {
+		exps = append(exps, new(big.Int).Rand(rng, new(big.Int).Lsh(big.NewInt(1), 300)))
+	}
+
+	for i := 0; i < 10; i++ {
+		x := randomXFieldElement(rng)
+		for _, exp := range exps {
+			if got, want := x.PowBig(exp), powBigNaive(x, exp); !got.Equal(want) {
+				t.Fatalf("%v^%s = %v, want %v", x, exp, got, want)
+			}
+		}
+		if !x.PowBig(big.NewInt(-7)).Equal(x.Inverse().Pow(7)) {
+			t.Fatal("negative exponent differs from inverting")
+		}
+	}
+
+	if !Zero.PowBig(big.NewInt(0)).IsOne() || !Zero.PowBig(bigGroupOrder).IsZero() {
+		t.Error("wrong powers of zero")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for 0^-1")
+		}
+	}()
+	Zero.PowBig(big.NewInt(-1))
+}
+
+func TestPowSigned(t *testing.T) {
+	rng := rand.New(rand.NewSource(543))
+	for i := 0; i < 20; i++ {
+		x := randomXFieldElement(rng)
+		k := int64(rng.Uint32())
+		if !x.PowSigned(k).Equal(x.Pow(uint64(k))) || !x.PowSigned(-k).Mul(x.PowSigned(k)).IsOne() {
+			t.Fatalf("PowSigned(±%d) wrong for %v", k, x)
+		}
+		if !x.PowSigned(math.MinInt64).Equal(x.Inverse().Pow(1 << 63)) {
+			t.Fatal("PowSigned(MinInt64) wrong")
+		}
+	}
+}
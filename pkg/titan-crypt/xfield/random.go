@@ -0,0 +1,46 @@
+package xfield
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// Random returns a uniformly distributed element sampled from crypto/rand.
+//
+// Panics if crypto/rand fails to produce bytes.
+func Random() XFieldElement {
+	x, err := RandomFrom(rand.Reader)
+	if err != nil {
+		panic(fmt.Sprintf("xfield: reading from crypto/rand failed: %v", err))
+	}
+	return x
+}
+
+// RandomFrom returns a uniformly distributed element sampled from r. The
+// coefficients c₀, c₁, c₂ are drawn in that order with field.RandomFill, so r
+// is consumed exactly as by three calls to field.RandomFrom and a
+// deterministic stream yields the same element in every implementation that
+// follows that rule. Returns an error if reading from r fails.
+func RandomFrom(r io.Reader) (XFieldElement, error) {
+	var coeffs [ExtensionDegree]field.Element
+	if err := field.RandomFill(coeffs[:], r); err != nil {
+		return Zero, fmt.Errorf("sampling extension field element: %w", err)
+	}
+	return New(coeffs), nil
+}
+
+// SampleFromElements builds an element from the first three base field
+// elements, taken as c₀, c₁, c₂. If those are uniform, for example sponge
+// output squeezed for a Fiat–Shamir challenge, so is the result; this is how
+// twenty-first's sample_scalars turns consecutive triples into extension
+// field challenges. Elements beyond the first three are ignored.
+// Returns an error if fewer than three elements are given.
+func SampleFromElements(elements []field.Element) (XFieldElement, error) {
+	if len(elements) < ExtensionDegree {
+		return Zero, fmt.Errorf("sampling extension field element: need %d base elements, got %d", ExtensionDegree, len(elements))
+	}
+	return New([ExtensionDegree]field.Element(elements[:ExtensionDegree])), nil
+}
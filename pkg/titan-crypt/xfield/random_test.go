@@ -0,0 +1,66 @@
+package xfield
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestRandomFromMatchesBaseSampling(t *testing.T) {
+	rng := rand.New(rand.NewSource(541))
+	var stream []byte
+	for i := 0; i < 40; i++ {
+		v := rng.Uint64()
+		if i%4 == 1 {
+			v = field.P + uint64(i) // rejected
+		}
+		stream = binary.LittleEndian.AppendUint64(stream, v)
+	}
+
+	xReader, bReader := bytes.NewReader(stream), bytes.NewReader(stream)
+	for i := 0; i < 5; i++ {
+		x, err := RandomFrom(xReader)
+		if err != nil {
+			t.Fatalf("RandomFrom failed: %v", err)
+		}
+		for j, c := range x.Coefficients {
+			want, _ := field.RandomFrom(bReader)
+			if !c.Equal(want) {
+				t.Fatalf("sample %d coefficient %d = %v, want %v", i, j, c, want)
+			}
+		}
+	}
+
+	if _, err := RandomFrom(bytes.NewReader(stream[:20])); err == nil {
+		t.Error("RandomFrom on a short reader should fail")
+	}
+}
+
+func TestRandom(t *testing.T) {
+	x, y := Random(), Random()
+	if x.Equal(y) || x.IsInBaseField() {
+		t.Errorf("unexpected Random values %v and %v", x, y)
+	}
+}
+
+func TestSampleFromElements(t *testing.T) {
+	elements := []field.Element{field.New(1), field.New(2), field.New(3), field.New(4)}
+	x, err := SampleFromElements(elements)
+	if err != nil {
+		t.Fatalf("SampleFromElements failed: %v", err)
+	}
+	if want := New([ExtensionDegree]field.Element{field.New(1), field.New(2), field.New(3)}); !x.Equal(want) {
+		t.Errorf("SampleFromElements = %v, want %v", x, want)
+	}
+
+	elements[0] = field.Zero
+	if x.Coefficients[0].IsZero() {
+		t.Error("result aliases the input slice")
+	}
+	if _, err := SampleFromElements(elements[:2]); err == nil {
+		t.Error("expected error for two elements")
+	}
+}
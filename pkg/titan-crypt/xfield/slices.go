@@ -0,0 +1,128 @@
+package xfield
+
+import (
+	"fmt"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// MulConstSlices stores xs[i] · scalars[i] in dst[i] for every i, multiplying
+// each coefficient by the base field factor: three base multiplications per
+// element instead of the nine of Mul(NewConst(scalars[i])). This is the
+// twiddle scaling step of extension field codewords. dst may alias xs.
+// Returns an error if the slices differ in length.
+func MulConstSlices(dst, xs []XFieldElement, scalars []field.Element) error {
+	if len(xs) != len(dst) || len(scalars) != len(dst) {
+		return fmt.Errorf("slice lengths differ: dst %d, xs %d, scalars %d", len(dst), len(xs), len(scalars))
+	}
+	xs, scalars = xs[:len(dst)], scalars[:len(dst)]
+	for i := range dst {
+		// Working on the coefficients directly rather than calling MulConst,
+		// which is not inlined, avoids copying each element in and out; see
+		// BenchmarkMulConstSlices16384 and BenchmarkLiftedMul16384
+		s, c := scalars[i], &xs[i].Coefficients
+		dst[i].Coefficients = [ExtensionDegree]field.Element{c[0].Mul(s), c[1].Mul(s), c[2].Mul(s)}
+	}
+	return nil
+}
+
+// UnflattenElements is the inverse of AsFlatSlice, grouping consecutive
+// coefficient triples into extension field elements.
+// Returns an error if len(elements) is not a multiple of ExtensionDegree.
+func UnflattenElements(elements []field.Element) ([]XFieldElement, error) {
+	if len(elements)%ExtensionDegree != 0 {
+		return nil, fmt.Errorf("%d elements is not a multiple of the extension degree %d", len(elements), ExtensionDegree)
+	}
+	xs := make([]XFieldElement, len(elements)/ExtensionDegree)
+	for i := range xs {
+		copy(xs[i].Coefficients[:], elements[ExtensionDegree*i:])
+	}
+	return xs, nil
+}
+
+// The bulk operations below mirror field's: they write into a caller-provided
+// dst, which may alias an input, and work on the coefficient arrays directly,
+// because the per-element methods are not inlined and copying each element
+// in and out dominated the loop (compare BenchmarkMulSlices16384 with
+// BenchmarkMulLoop16384).
+
+// AddSlices stores a[i] + b[i] in dst[i] for every i.
+// Returns an error if the slices differ in length.
+func AddSlices(dst, a, b []XFieldElement) error {
+	if err := checkSliceLengths(dst, a, b); err != nil {
+		return err
+	}
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		x, y := &a[i].Coefficients, &b[i].Coefficients
+		dst[i].Coefficients = [ExtensionDegree]field.Element{x[0].Add(y[0]), x[1].Add(y[1]), x[2].Add(y[2])}
+	}
+	return nil
+}
+
+// SubSlices stores a[i] - b[i] in dst[i] for every i.
+// Returns an error if the slices differ in length.
+func SubSlices(dst, a, b []XFieldElement) error {
+	if err := checkSliceLengths(dst, a, b); err != nil {
+		return err
+	}
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		x, y := &a[i].Coefficients, &b[i].Coefficients
+		dst[i].Coefficients = [ExtensionDegree]field.Element{x[0].Sub(y[0]), x[1].Sub(y[1]), x[2].Sub(y[2])}
+	}
+	return nil
+}
+
+// MulSlices stores a[i] · b[i] in dst[i] for every i. Each product takes six
+// base multiplications by Karatsuba, against ten in Mul.
+// Returns an error if the slices differ in length.
+func MulSlices(dst, a, b []XFieldElement) error {
+	if err := checkSliceLengths(dst, a, b); err != nil {
+		return err
+	}
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		// Written out rather than shared with ScaleSlice: a helper taking the
+		// coefficients is not inlined and slowed this loop down
+		x, y := &a[i].Coefficients, &b[i].Coefficients
+		p0, p1, p2 := x[0].Mul(y[0]), x[1].Mul(y[1]), x[2].Mul(y[2])
+
+		// Coefficients t1..t3 of the degree-4 product; t0 = p0 and t4 = p2
+		t1 := x[0].Add(x[1]).Mul(y[0].Add(y[1])).Sub(p0).Sub(p1)
+		t2 := x[0].Add(x[2]).Mul(y[0].Add(y[2])).Sub(p0).Sub(p2).Add(p1)
+		t3 := x[1].Add(x[2]).Mul(y[1].Add(y[2])).Sub(p1).Sub(p2)
+
+		// Fold with x³ = x - 1 and x⁴ = x² - x
+		dst[i].Coefficients = [ExtensionDegree]field.Element{p0.Sub(t3), t1.Add(t3).Sub(p2), t2.Add(p2)}
+	}
+	return nil
+}
+
+// ScaleSlice stores scalar · a[i] in dst[i] for every i, by the Karatsuba
+// product of MulSlices with the scalar's coefficient sums computed once.
+// Returns an error if the slices differ in length.
+func ScaleSlice(dst, a []XFieldElement, scalar XFieldElement) error {
+	if len(a) != len(dst) {
+		return fmt.Errorf("slice lengths differ: dst %d, a %d", len(dst), len(a))
+	}
+	a = a[:len(dst)]
+	s := scalar.Coefficients
+	s01, s02, s12 := s[0].Add(s[1]), s[0].Add(s[2]), s[1].Add(s[2])
+	for i := range dst {
+		x := &a[i].Coefficients
+		p0, p1, p2 := x[0].Mul(s[0]), x[1].Mul(s[1]), x[2].Mul(s[2])
+		t1 := x[0].Add(x[1]).Mul(s01).Sub(p0).Sub(p1)
+		t2 := x[0].Add(x[2]).Mul(s02).Sub(p0).Sub(p2).Add(p1)
+		t3 := x[1].Add(x[2]).Mul(s12).Sub(p1).Sub(p2)
+		dst[i].Coefficients = [ExtensionDegree]field.Element{p0.Sub(t3), t1.Add(t3).Sub(p2), t2.Add(p2)}
+	}
+	return nil
+}
+
+func checkSliceLengths(dst, a, b []XFieldElement) error {
+	if len(a) != len(dst) || len(b) != len(dst) {
+		return fmt.Errorf("slice lengths differ: dst %d, a %d, b %d", len(dst), len(a), len(b))
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package xfield
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestMixedOperandsMatchLifted(t *testing.T) {
+	rng := rand.New(rand.NewSource(542))
+	for i := 0; i < 100; i++ {
+		x := randomXFieldElement(rng)
+		c := field.New(rng.Uint64())
+		lifted := NewConst(c)
+
+		if !x.MulConst(c).Equal(x.Mul(lifted)) || !x.AddConst(c).Equal(x.Add(lifted)) ||
+			!x.SubConst(c).Equal(x.Sub(lifted)) {
+			t.Fatalf("mixed operation differs from lifted one for %v, %v", x, c)
+		}
+		if !c.IsZero() && !x.DivConst(c).Equal(x.Div(lifted)) {
+			t.Fatalf("DivConst(%v) differs from Div by the lifted element", c)
+		}
+	}
+}
+
+func TestMulConstSlices(t *testing.T) {
+	rng := rand.New(rand.NewSource(542))
+	for _, n := range []int{0, 1, 100} {
+		xs := make([]XFieldElement, n)
+		scalars := make([]field.Element, n)
+		for i := range xs {
+			xs[i], scalars[i] = randomXFieldElement(rng), field.New(rng.Uint64())
+		}
+
+		dst := make([]XFieldElement, n)
+		if err := MulConstSlices(dst, xs, scalars); err != nil {
+			t.Fatal(err)
+		}
+		for i := range dst {
+			if !dst[i].Equal(xs[i].Mul(NewConst(scalars[i]))) {
+				t.Fatalf("n=%d: mismatch at %d", n, i)
+			}
+		}
+
+		// In place
+		if err := MulConstSlices(xs, xs, scalars); err != nil {
+			t.Fatal(err)
+		}
+		for i := range xs {
+			if !xs[i].Equal(dst[i]) {
+				t.Fatalf("n=%d: in-place mismatch at %d", n, i)
+			}
+		}
+	}
+
+	if MulConstSlices(make([]XFieldElement, 2), make([]XFieldElement, 2), make([]field.Element, 3)) == nil {
+		t.Error("mismatched lengths should fail")
+	}
+}
+
+func benchmarkCodeword() ([]XFieldElement, []field.Element) {
+	rng := rand.New(rand.NewSource(542))
+	xs := make([]XFieldElement, 1<<14)
+	twiddles := make([]field.Element, 1<<14)
+	for i := range xs {
+		xs[i], twiddles[i] = randomXFieldElement(rng), field.New(rng.Uint64())
+	}
+	return xs, twiddles
+}
+
+func BenchmarkMulConstSlices16384(b *testing.B) {
+	xs, twiddles := benchmarkCodeword()
+	dst := make([]XFieldElement, len(xs))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = MulConstSlices(dst, xs, twiddles)
+	}
+}
+
+func BenchmarkLiftedMul16384(b *testing.B) {
+	xs, twiddles := benchmarkCodeword()
+	dst := make([]XFieldElement, len(xs))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range dst {
+			dst[j] = xs[j].Mul(NewConst(twiddles[j]))
+		}
+	}
+}
+
+func TestSliceArithmetic(t *testing.T) {
+	rng := rand.New(rand.NewSource(547))
+	for _, n := range []int{0, 1, 100} {
+		a, b := make([]XFieldElement, n), make([]XFieldElement, n)
+		for i := range a {
+			a[i], b[i] = randomXFieldElement(rng), randomXFieldElement(rng)
+		}
+		if n > 1 {
+			a[0], b[1] = New([ExtensionDegree]field.Element{field.Max, field.Max, field.Max}), Zero
+		}
+		scalar := randomXFieldElement(rng)
+
+		sum, diff := make([]XFieldElement, n), make([]XFieldElement, n)
+		prod, scaled := make([]XFieldElement, n), make([]XFieldElement, n)
+		if AddSlices(sum, a, b) != nil || SubSlices(diff, a, b) != nil ||
+			MulSlices(prod, a, b) != nil || ScaleSlice(scaled, a, scalar) != nil {
+			t.Fatal("matching lengths should succeed")
+		}
+		for i := range a {
+			if !sum[i].Equal(a[i].Add(b[i])) || !diff[i].Equal(a[i].Sub(b[i])) ||
+				!prod[i].Equal(a[i].Mul(b[i])) || !scaled[i].Equal(a[i].Mul(scalar)) {
+				t.Fatalf("n=%d: mismatch at %d", n, i)
+			}
+		}
+
+		// In place
+		if err := MulSlices(a, a, b); err != nil {
+			t.Fatal(err)
+		}
+		for i := range a {
+			if !a[i].Equal(prod[i]) {
+				t.Fatalf("n=%d: in-place MulSlices mismatch at %d", n, i)
+			}
+		}
+	}
+
+	long, short := make([]XFieldElement, 3), make([]XFieldElement, 2)
+	if AddSlices(long, long, short) == nil || SubSlices(short, long, long) == nil ||
+		MulSlices(long, short, long) == nil || ScaleSlice(short, long, One) == nil {
+		t.Error("mismatched lengths should fail")
+	}
+}
+
+func BenchmarkMulSlices16384(b *testing.B) {
+	xs, _ := benchmarkCodeword()
+	ys := append([]XFieldElement(nil), xs...)
+	dst := make([]XFieldElement, len(xs))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = MulSlices(dst, xs, ys)
+	}
+}
+
+func BenchmarkMulLoop16384(b *testing.B) {
+	xs, _ := benchmarkCodeword()
+	ys := append([]XFieldElement(nil), xs...)
+	dst := make([]XFieldElement, len(xs))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range dst {
+			dst[j] = xs[j].Mul(ys[j])
+		}
+	}
+}
+
+func TestUnflattenElements(t *testing.T) {
+	rng := rand.New(rand.NewSource(553))
+	xs := make([]XFieldElement, 5)
+	for i := range xs {
+		xs[i] = randomXFieldElement(rng)
+	}
+
+	flat := AsFlatSlice(xs)
+	if len(flat) != 15 {
+		t.Fatalf("flattened to %d elements, want 15", len(flat))
+	}
+	for i, x := range xs {
+		want := x.ToElements()
+		for j := range want {
+			if !flat[3*i+j].Equal(want[j]) || !want[j].Equal(x.Coefficients[j]) {
+				t.Fatalf("element %d coefficient %d out of order", i, j)
+			}
+		}
+	}
+
+	back, err := UnflattenElements(flat)
+	if err != nil || len(back) != len(xs) {
+		t.Fatalf("UnflattenElements = %v, %v", back, err)
+	}
+	for i := range xs {
+		if !back[i].Equal(xs[i]) {
+			t.Fatalf("round trip mismatch at %d", i)
+		}
+	}
+
+	if back, err := UnflattenElements(AsFlatSlice(nil)); err != nil || len(back) != 0 {
+		t.Errorf("UnflattenElements(AsFlatSlice(nil)) = %v, %v", back, err)
+	}
+	if _, err := UnflattenElements(flat[:14]); err == nil {
+		t.Error("a length that is not a multiple of 3 should fail")
+	}
+}
@@ -0,0 +1,28 @@
+package xfield
+
+import (
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+// Sqrt returns a square root of x and true, or Zero and false if x is not a
+// square. Which of the two roots ±r is returned is unspecified.
+//
+// Because the extension degree is odd, x is a square exactly when its norm
+// N(x) = x^m, m = 1 + p + p², is a square in F_p. With a = x^((m+1)/2) we get
+// a² = x·N(x), so √x = a / √N(x), where the base field square root is taken
+// with field.Element.Sqrt. Since (m+1)/2 = p·(p+1)/2 + 1, a = φ(x^((p+1)/2))·x
+// costs one 64-bit exponentiation and a Frobenius map rather than a full
+// exponentiation by a 127-bit exponent.
+func (x XFieldElement) Sqrt() (XFieldElement, bool) {
+	if x.IsZero() {
+		return Zero, true
+	}
+
+	normRoot, ok := x.Norm().Sqrt()
+	if !ok {
+		return Zero, false
+	}
+
+	a := x.Pow((field.P + 1) / 2).Frobenius().Mul(x)
+	return a.MulConst(normRoot.Inverse()), true
+}
@@ -0,0 +1,48 @@
+package xfield
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func TestSqrt(t *testing.T) {
+	rng := rand.New(rand.NewSource(538))
+	squares, nonSquares := 0, 0
+	for i := 0; i < 200; i++ {
+		z := randomXFieldElement(rng)
+
+		// Squares always have a root, and it squares back
+		root, ok := z.Mul(z).Sqrt()
+		if !ok || !root.Mul(root).Equal(z.Mul(z)) {
+			t.Fatalf("Sqrt(%v²) = %v, %v", z, root, ok)
+		}
+
+		// Random elements are squares about half the time
+		root, ok = z.Sqrt()
+		if ok {
+			squares++
+			if !root.Mul(root).Equal(z) {
+				t.Fatalf("Sqrt(%v) = %v does not square back", z, root)
+			}
+		} else {
+			nonSquares++
+			if _, isSquare := z.Norm().Sqrt(); isSquare {
+				t.Fatalf("Sqrt(%v) failed although its norm is a square", z)
+			}
+		}
+	}
+	if squares < 50 || nonSquares < 50 {
+		t.Errorf("%d squares and %d non-squares out of 200", squares, nonSquares)
+	}
+
+	if root, ok := Zero.Sqrt(); !ok || !root.IsZero() {
+		t.Error("Sqrt(0) should be 0")
+	}
+
+	// A base field non-square stays a non-square in the odd-degree extension
+	if _, ok := NewConst(field.Generator()).Sqrt(); ok {
+		t.Error("the generator of F_p* should not be a square in F_p³")
+	}
+}
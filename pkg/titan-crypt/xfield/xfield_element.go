@@ -13,6 +13,7 @@ package xfield
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
@@ -36,6 +37,10 @@ type XFieldElement struct {
 	Coefficients [ExtensionDegree]field.Element
 }
 
+// ErrDivisionByZero is returned by InverseChecked and DivChecked when the
+// element to invert is zero. Test for it with errors.Is.
+var ErrDivisionByZero = errors.New("division by zero in the extension field")
+
 // Common extension field elements
 var (
 	Zero = XFieldElement{[ExtensionDegree]field.Element{field.Zero, field.Zero, field.Zero}}
@@ -85,18 +90,33 @@ func (x XFieldElement) Equal(other XFieldElement) bool {
 		x.Coefficients[2].Equal(other.Coefficients[2])
 }
 
+// IsInBaseField returns true if the element lies in the prime subfield, i.e. c₁ = c₂ = 0.
+func (x XFieldElement) IsInBaseField() bool {
+	return x.Coefficients[1].IsZero() && x.Coefficients[2].IsZero()
+}
+
 // Unlift attempts to convert an extension field element to a base field element.
 // Returns the base field element if c₁ = c₂ = 0, otherwise returns nil.
 //
 // This is equivalent to twenty-first's XFieldElement::unlift()
 func (x XFieldElement) Unlift() *field.Element {
-	if x.Coefficients[1].IsZero() && x.Coefficients[2].IsZero() {
+	if x.IsInBaseField() {
 		result := x.Coefficients[0]
 		return &result
 	}
 	return nil
 }
 
+// UnliftChecked is Unlift for callers that prefer an error to a nil pointer:
+// it returns the base field element, or an error where Unlift returns nil.
+func (x XFieldElement) UnliftChecked() (field.Element, error) {
+	unlifted := x.Unlift()
+	if unlifted == nil {
+		return field.Zero, fmt.Errorf("cannot unlift %v: element is not in the base field", x)
+	}
+	return *unlifted, nil
+}
+
 // String returns the string representation of the extension field element.
 func (x XFieldElement) String() string {
 	// If it's a constant (unlifted), show it as such
@@ -227,6 +247,42 @@ func (x XFieldElement) MulConst(scalar field.Element) XFieldElement {
 	}
 }
 
+// DivConst divides an extension field element by a nonzero base field element.
+// It costs one base field inversion and three multiplications, unlike Div by
+// NewConst(scalar), which inverts in the extension field.
+//
+// Panics if scalar is zero.
+func (x XFieldElement) DivConst(scalar field.Element) XFieldElement {
+	return x.MulConst(scalar.Inverse())
+}
+
+// MulX multiplies an extension field element by the indeterminate x. Since
+// x³ = x - 1, x·(c₀ + c₁x + c₂x²) = -c₂ + (c₀ + c₂)x + c₁x², which costs one
+// negation and one addition instead of a full Mul.
+func (x XFieldElement) MulX() XFieldElement {
+	c0, c1, c2 := x.Coefficients[0], x.Coefficients[1], x.Coefficients[2]
+	return XFieldElement{
+		Coefficients: [ExtensionDegree]field.Element{c2.Neg(), c0.Add(c2), c1},
+	}
+}
+
+// mulXPowLoopLimit is the exponent from which MulXPow multiplies by x^k rather
+// than applying MulX k times, chosen where the two break even; see
+// BenchmarkMulXLoopAtLimit and BenchmarkMulXPowAtLimit.
+const mulXPowLoopLimit = 192
+
+// MulXPow multiplies an extension field element by x^k. Small k apply MulX k
+// times; larger k multiply by x^k computed with Pow.
+func (x XFieldElement) MulXPow(k uint64) XFieldElement {
+	if k >= mulXPowLoopLimit {
+		return x.Mul(New([ExtensionDegree]field.Element{field.Zero, field.One, field.Zero}).Pow(k))
+	}
+	for ; k > 0; k-- {
+		x = x.MulX()
+	}
+	return x
+}
+
 // ShahPolynomial returns the irreducible polynomial defining the extension: x³ - x + 1
 //
 // This is equivalent to twenty-first's XFieldElement::shah_polynomial()
@@ -301,6 +357,23 @@ func (x XFieldElement) Inverse() XFieldElement {
 	return XFieldElement{Coefficients: resultCoeffs}
 }
 
+// InverseOrZero returns the multiplicative inverse, or Zero for Zero instead of panicking.
+func (x XFieldElement) InverseOrZero() XFieldElement {
+	if x.IsZero() {
+		return Zero
+	}
+	return x.Inverse()
+}
+
+// InverseChecked returns the multiplicative inverse of x, or an error wrapping
+// ErrDivisionByZero if x is zero, for callers that must not panic.
+func (x XFieldElement) InverseChecked() (XFieldElement, error) {
+	if x.IsZero() {
+		return Zero, fmt.Errorf("cannot invert %v: %w", x, ErrDivisionByZero)
+	}
+	return x.Inverse(), nil
+}
+
 // Div performs extension field division: x / y = x * y⁻¹
 //
 // This is equivalent to twenty-first's Div for XFieldElement
@@ -308,6 +381,16 @@ func (x XFieldElement) Div(other XFieldElement) XFieldElement {
 	return x.Mul(other.Inverse())
 }
 
+// DivChecked returns x / other, or an error wrapping ErrDivisionByZero if
+// other is zero.
+func (x XFieldElement) DivChecked(other XFieldElement) (XFieldElement, error) {
+	inverse, err := other.InverseChecked()
+	if err != nil {
+		return Zero, err
+	}
+	return x.Mul(inverse), nil
+}
+
 // Pow computes x^exponent using square-and-multiply algorithm.
 //
 // This is equivalent to twenty-first's ModPowU64 for XFieldElement
@@ -326,34 +409,46 @@ func (x XFieldElement) Pow(exponent uint64) XFieldElement {
 	return result
 }
 
-// MarshalJSON implements json.Marshaler.
-// Extension field elements are serialized as arrays of 3 base field elements.
+// MarshalJSON implements json.Marshaler. The coefficients are written as an
+// array [c0,c1,c2], each in field.Element's JSON form, a decimal string of the
+// canonical value.
 func (x XFieldElement) MarshalJSON() ([]byte, error) {
-	// Serialize as array of coefficient values
-	values := [ExtensionDegree]uint64{
-		x.Coefficients[0].Value(),
-		x.Coefficients[1].Value(),
-		x.Coefficients[2].Value(),
-	}
-	return json.Marshal(values)
+	return json.Marshal(x.Coefficients)
 }
 
-// UnmarshalJSON implements json.Unmarshaler.
+// UnmarshalJSON implements json.Unmarshaler. It expects an array of exactly three
+// coefficients, each accepted by field.Element.UnmarshalJSON, so the bare u64
+// numbers written by earlier versions still decode. Coefficients must be canonical
+// and not null. On error x is left unchanged.
 func (x *XFieldElement) UnmarshalJSON(data []byte) error {
-	var values [ExtensionDegree]uint64
-	if err := json.Unmarshal(data, &values); err != nil {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
 		return err
 	}
+	if len(raw) != ExtensionDegree {
+		return fmt.Errorf("expected %d coefficients, got %d", ExtensionDegree, len(raw))
+	}
 
-	x.Coefficients = [ExtensionDegree]field.Element{
-		field.New(values[0]),
-		field.New(values[1]),
-		field.New(values[2]),
+	var coeffs [ExtensionDegree]field.Element
+	for i, r := range raw {
+		if string(r) == "null" {
+			return fmt.Errorf("coefficient %d is null", i)
+		}
+		if err := coeffs[i].UnmarshalJSON(r); err != nil {
+			return fmt.Errorf("invalid coefficient %d: %w", i, err)
+		}
 	}
 
+	x.Coefficients = coeffs
 	return nil
 }
 
+// ToElements returns the coefficients [c₀, c₁, c₂] as a slice, the order in
+// which twenty-first absorbs an XFieldElement into a sponge.
+func (x XFieldElement) ToElements() []field.Element {
+	return []field.Element{x.Coefficients[0], x.Coefficients[1], x.Coefficients[2]}
+}
+
 // ToDigest converts an extension field element to a 5-element digest.
 // The three coefficients become the first three digest elements, with zeros padding.
 //
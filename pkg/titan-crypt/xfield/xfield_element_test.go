@@ -2,6 +2,8 @@ package xfield
 
 import (
 	"encoding/json"
+	"errors"
+	"math/rand"
 	"testing"
 
 	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
@@ -319,6 +321,27 @@ func TestXFieldElementInverseZeroPanics(t *testing.T) {
 	_ = Zero.Inverse()
 }
 
+func TestXFieldElementInverseChecked(t *testing.T) {
+	if _, err := Zero.InverseChecked(); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("InverseChecked(Zero) error = %v, want ErrDivisionByZero", err)
+	}
+	if _, err := One.DivChecked(Zero); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("DivChecked by Zero error = %v, want ErrDivisionByZero", err)
+	}
+
+	x := New([3]field.Element{field.New(1), field.New(2), field.New(3)})
+	y := New([3]field.Element{field.New(4), field.Zero, field.Max})
+	if inv, err := x.InverseChecked(); err != nil || !inv.Equal(x.Inverse()) {
+		t.Errorf("InverseChecked(%v) = %v, %v", x, inv, err)
+	}
+	if q, err := x.DivChecked(y); err != nil || !q.Equal(x.Div(y)) {
+		t.Errorf("DivChecked = %v, %v", q, err)
+	}
+	if q, err := Zero.DivChecked(y); err != nil || !q.IsZero() {
+		t.Errorf("DivChecked of Zero = %v, %v", q, err)
+	}
+}
+
 func TestXFieldElementDiv(t *testing.T) {
 	tests := []struct {
 		name string
@@ -444,6 +467,40 @@ func TestXFieldElementUnlift(t *testing.T) {
 	}
 }
 
+func TestXFieldElementIsInBaseField(t *testing.T) {
+	tests := []struct {
+		name string
+		xfe  XFieldElement
+		want bool
+	}{
+		{"Zero", Zero, true},
+		{"Lifted constant", NewConst(field.New(42)), true},
+		{"c1 != 0", New([3]field.Element{field.New(7), field.One, field.Zero}), false},
+		{"c2 != 0", New([3]field.Element{field.New(7), field.Zero, field.One}), false},
+		{"Full extension element", New([3]field.Element{field.New(1), field.New(2), field.New(3)}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.xfe.IsInBaseField(); got != tt.want {
+				t.Errorf("IsInBaseField() = %v, want %v", got, tt.want)
+			}
+
+			got, err := tt.xfe.UnliftChecked()
+			if tt.want {
+				if err != nil {
+					t.Fatalf("UnliftChecked() failed: %v", err)
+				}
+				if !got.Equal(tt.xfe.Coefficients[0]) {
+					t.Errorf("UnliftChecked() = %v, want %v", got, tt.xfe.Coefficients[0])
+				}
+			} else if err == nil {
+				t.Errorf("UnliftChecked() = %v, expected error", got)
+			}
+		})
+	}
+}
+
 func TestXFieldElementJSONSerialization(t *testing.T) {
 	tests := []struct {
 		name string
@@ -658,3 +715,106 @@ func BenchmarkXFieldElementInverse(b *testing.B) {
 		_ = x.Inverse()
 	}
 }
+
+func TestXFieldElementUnmarshalJSONRejectsNonCanonical(t *testing.T) {
+	inputs := []string{
+		"[18446744069414584321, 0, 0]",
+		"[0, 18446744073709551615, 0]",
+		"[0, 0, 18446744069414584322]",
+	}
+
+	for _, input := range inputs {
+		var x XFieldElement
+		if err := json.Unmarshal([]byte(input), &x); err == nil {
+			t.Errorf("UnmarshalJSON(%s) accepted a non-canonical coefficient", input)
+		}
+	}
+
+	var x XFieldElement
+	if err := json.Unmarshal([]byte("[18446744069414584320, 1, 2]"), &x); err != nil {
+		t.Errorf("UnmarshalJSON rejected canonical coefficients: %v", err)
+	}
+}
+
+func TestXFieldElementJSONFormat(t *testing.T) {
+	x := New([3]field.Element{field.New(1), field.Max, field.Zero})
+	data, err := json.Marshal(x)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	// Each coefficient uses field.Element's JSON form
+	if want := `["1","18446744069414584320","0"]`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	for _, input := range []string{
+		`["1","18446744069414584320","0"]`,
+		`[1,18446744069414584320,0]`,
+		` [ "1", 18446744069414584320, "0" ] `,
+	} {
+		var got XFieldElement
+		if err := json.Unmarshal([]byte(input), &got); err != nil || !got.Equal(x) {
+			t.Errorf("Unmarshal(%s) = %v, %v; want %v", input, got, err, x)
+		}
+	}
+}
+
+func TestXFieldElementUnmarshalJSONRejectsMalformed(t *testing.T) {
+	for _, input := range []string{
+		`[1,2]`,
+		`[1,2,3,4]`,
+		`[1,null,3]`,
+		`[1,-2,3]`,
+		`[1,"x",3]`,
+		`{"coefficients":[1,2,3]}`,
+		`"1,2,3"`,
+	} {
+		x := One
+		if err := json.Unmarshal([]byte(input), &x); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, expected error", input, x)
+		}
+		if !x.Equal(One) {
+			t.Errorf("failed Unmarshal(%s) modified the receiver", input)
+		}
+	}
+}
+
+func TestMulX(t *testing.T) {
+	rng := rand.New(rand.NewSource(550))
+	indeterminate := New([3]field.Element{field.Zero, field.One, field.Zero})
+	for i := 0; i < 100; i++ {
+		x := randomXFieldElement(rng)
+		if !x.MulX().Equal(x.Mul(indeterminate)) {
+			t.Fatalf("MulX(%v) differs from Mul by x", x)
+		}
+		for _, k := range []uint64{0, 1, 2, 3, 7, mulXPowLoopLimit - 1, mulXPowLoopLimit, 1000, rng.Uint64()} {
+			if got, want := x.MulXPow(k), x.Mul(indeterminate.Pow(k)); !got.Equal(want) {
+				t.Fatalf("MulXPow(%d) of %v = %v, want %v", k, x, got, want)
+			}
+		}
+	}
+}
+
+// BenchmarkMulXLoopAtLimit and BenchmarkMulXPowAtLimit compare the two MulXPow
+// strategies at mulXPowLoopLimit, where they should cost about the same.
+func BenchmarkMulXLoopAtLimit(b *testing.B) {
+	x := New([3]field.Element{field.New(1), field.New(2), field.New(3)})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		y := x
+		for k := 0; k < mulXPowLoopLimit; k++ {
+			y = y.MulX()
+		}
+		_ = y
+	}
+}
+
+func BenchmarkMulXPowAtLimit(b *testing.B) {
+	x := New([3]field.Element{field.New(1), field.New(2), field.New(3)})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = x.MulXPow(mulXPowLoopLimit)
+	}
+}
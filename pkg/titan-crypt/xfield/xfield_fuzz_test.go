@@ -0,0 +1,64 @@
+package xfield
+
+import (
+	"testing"
+
+	"github.com/dread-crypto/titan-crypt/pkg/titan-crypt/field"
+)
+
+func FuzzXFieldInverse(f *testing.F) {
+	// Coordinates at and around the modulus boundary
+	boundary := []uint64{0, 1, field.P - 1, ^uint64(0)}
+	for _, c0 := range boundary {
+		for _, c1 := range boundary {
+			for _, c2 := range boundary {
+				f.Add(c0, c1, c2)
+			}
+		}
+	}
+	f.Add(field.P, field.P+1, uint64(1)<<32)
+
+	f.Fuzz(func(t *testing.T, c0, c1, c2 uint64) {
+		// Coordinates are raw Montgomery words, so values near P reach Mul and
+		// Inverse unreduced. Words at or above P are not valid elements and
+		// must be rejected rather than constructed.
+		var coords [3]field.Element
+		for i, raw := range [3]uint64{c0, c1, c2} {
+			c, err := field.NewFromRawChecked(raw)
+			if !field.IsCanonical(raw) {
+				if err == nil {
+					t.Fatalf("NewFromRawChecked(%d) accepted a non-canonical word", raw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewFromRawChecked(%d): %v", raw, err)
+			}
+			coords[i] = c
+		}
+		x := New(coords)
+
+		inv := x.InverseOrZero()
+		if x.IsZero() {
+			if !inv.IsZero() {
+				t.Fatalf("InverseOrZero(0) = %v, want 0", inv)
+			}
+			return
+		}
+
+		for i, c := range inv.Coefficients {
+			if !field.IsCanonical(c.RawValue()) {
+				t.Fatalf("inverse coefficient %d has non-canonical raw word %d", i, c.RawValue())
+			}
+		}
+		if product := x.Mul(inv); !product.IsOne() {
+			t.Fatalf("(%d, %d, %d) · inverse = %v, want 1", c0, c1, c2, product)
+		}
+		if !inv.Equal(x.Inverse()) {
+			t.Fatalf("InverseOrZero differs from Inverse for (%d, %d, %d)", c0, c1, c2)
+		}
+		if back := inv.Inverse(); !back.Equal(x) {
+			t.Fatalf("double inverse of (%d, %d, %d) = %v", c0, c1, c2, back)
+		}
+	})
+}